@@ -31,10 +31,17 @@ func (a Angle) String() string {
 	if a.IsNaN() {
 		return "NaN°"
 	}
-	d, df := math.Modf(a.Degrees())
+	d, df := math.Modf(math.Abs(a.Degrees()))
 	m, mf := math.Modf(df * 60)
 	s, sf := math.Modf(mf * 60)
-	return fmt.Sprintf("%d°%d'%d\".%d/%.5f", int(d), int(m), int(s), int(sf*1000), a.Degrees())
+	ms := int(sf * 1000)
+	// The fields hold |a|, so the sign goes in front, unless they are all
+	// zero.
+	sign := ""
+	if a < 0 && (d != 0 || m != 0 || s != 0 || ms != 0) {
+		sign = "-"
+	}
+	return fmt.Sprintf("%s%d°%d'%d\".%d/%.5f", sign, int(d), int(m), int(s), ms, a.Degrees())
 }
 
 func (a Angle) Sin() float64 {
@@ -1,23 +1,76 @@
 package goastro
 
 import (
+	"fmt"
+	"math"
+	"sync"
 	"time"
 )
 
+// A calendar date. Dates from GregorianReform() on are in the Gregorian
+// calendar, earlier ones in the Julian calendar. Years are numbered
+// astronomically: year 0 is 1 BC, year -1 is 2 BC, and so on.
 type Date struct {
 	Year, Month, Day int
 }
 
+// The first day of the Gregorian calendar; the day before it is the last
+// day of the Julian calendar.
+var (
+	reformMu        sync.RWMutex
+	gregorianReform = Date{1582, 10, 15}
+)
+
+// Returns the first day of the Gregorian calendar.
+func GregorianReform() Date {
+	reformMu.RLock()
+	defer reformMu.RUnlock()
+	return gregorianReform
+}
+
+// Sets the first day of the Gregorian calendar, e.g. to Date{1752, 9, 14}
+// to follow the British reform. d must be a Gregorian date no earlier
+// than 1582-10-15, the original reform.
+func SetGregorianReform(d Date) error {
+	if d.Month < 1 || d.Month > 12 || d.Day < 1 ||
+		dateInCalendar(gregorianJDN(d.Year, d.Month, d.Day), gregorianJDN) != d {
+		return fmt.Errorf("SetGregorianReform: invalid date %v", d)
+	}
+	if gregorianJDN(d.Year, d.Month, d.Day) < gregorianJDN(1582, 10, 15) {
+		return fmt.Errorf("SetGregorianReform: %v is before 1582-10-15", d)
+	}
+	reformMu.Lock()
+	defer reformMu.Unlock()
+	gregorianReform = d
+	return nil
+}
+
+// Converts the proleptic Gregorian date of t.
 func MakeDate(t time.Time) Date {
-	return Date{t.Year(), int(t.Month()), t.Day()}
+	return dateFromJDN(gregorianJDN(t.Year(), int(t.Month()), t.Day()))
 }
 
+// Returns midnight UTC at the start of d. time.Time uses the proleptic
+// Gregorian calendar, so Julian dates are converted.
 func (d Date) Time() time.Time {
-	return time.Date(d.Year, time.Month(d.Month), d.Day, 0, 0, 0, 0, time.UTC)
+	g := dateInCalendar(d.jdn(), gregorianJDN)
+	return time.Date(g.Year, time.Month(g.Month), g.Day, 0, 0, 0, 0, time.UTC)
+}
+
+func (d Date) String() string {
+	if d.Year < 0 {
+		return fmt.Sprintf("-%04d-%02d-%02d", -d.Year, d.Month, d.Day)
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
 }
 
 func (d Date) AddDays(n int) Date {
-	return MakeDate(d.Time().AddDate(0, 0, n))
+	return dateFromJDN(d.jdn() + n)
+}
+
+// Returns the number of days from d2 to d.
+func (d Date) Sub(d2 Date) int {
+	return d.jdn() - d2.jdn()
 }
 
 func (d Date) compareTo(d2 Date) int {
@@ -32,7 +85,130 @@ func (d Date) compareTo(d2 Date) int {
 	return d.Day - d2.Day
 }
 
-var gregorianStart = Date{1582, 10, 15}
+// Returns -1, 0 or +1 as d is before, equal to or after d2.
+func (d Date) Compare(d2 Date) int {
+	c := d.compareTo(d2)
+	switch {
+	case c < 0:
+		return -1
+	case c > 0:
+		return 1
+	}
+	return 0
+}
+
+func (d Date) Before(d2 Date) bool {
+	return d.compareTo(d2) < 0
+}
+
+func (d Date) After(d2 Date) bool {
+	return d.compareTo(d2) > 0
+}
+
+// Reports whether d is in the Gregorian (rather than Julian) calendar.
+func (d Date) IsGregorian() bool {
+	return d.compareTo(GregorianReform()) >= 0
+}
+
+// Reports whether d exists: the month and day are in range, and d is not
+// one of the days skipped by the calendar reform.
+func (d Date) IsValid() bool {
+	if d.Month < 1 || d.Month > 12 || d.Day < 1 || d.Day > 31 {
+		return false
+	}
+	return dateFromJDN(d.jdn()) == d
+}
+
+// Ch 7 p.65
+func (d Date) Weekday() time.Weekday {
+	return time.Weekday(floorMod(d.jdn()+1, 7))
+}
+
+// Ch 7 p.65
+func (d Date) DayOfYear() int {
+	return d.jdn() - Date{d.Year, 1, 1}.jdn() + 1
+}
+
+func (d Date) IsLeapYear() bool {
+	return IsLeapYear(d.Year)
+}
+
+// Ch 7 p.62
+// Uses the Julian rule for years before GregorianReform().
+func IsLeapYear(year int) bool {
+	if !(Date{year, 2, 28}).IsGregorian() {
+		return floorMod(year, 4) == 0
+	}
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// Returns the number of days in the given month, accounting for any days
+// dropped by the calendar reform.
+func DaysInMonth(year, month int) int {
+	next := Date{year, month + 1, 1}
+	if month == 12 {
+		next = Date{year + 1, 1, 1}
+	}
+	return next.jdn() - Date{year, month, 1}.jdn()
+}
+
+// Julian Day Number: the Julian Day at noon on d.
+func (d Date) jdn() int {
+	if d.IsGregorian() {
+		return gregorianJDN(d.Year, d.Month, d.Day)
+	}
+	return julianJDN(d.Year, d.Month, d.Day)
+}
+
+func gregorianJDN(y, m, d int) int {
+	a := floorDiv(14-m, 12)
+	y += 4800 - a
+	m += 12*a - 3
+	return d + (153*m+2)/5 + 365*y + floorDiv(y, 4) - floorDiv(y, 100) + floorDiv(y, 400) - 32045
+}
+
+func julianJDN(y, m, d int) int {
+	a := floorDiv(14-m, 12)
+	y += 4800 - a
+	m += 12*a - 3
+	return d + (153*m+2)/5 + 365*y + floorDiv(y, 4) - 32083
+}
+
+func dateFromJDN(n int) Date {
+	r := GregorianReform()
+	if n >= gregorianJDN(r.Year, r.Month, r.Day) {
+		return dateInCalendar(n, gregorianJDN)
+	}
+	return dateInCalendar(n, julianJDN)
+}
+
+// Finds the date with Julian Day Number n in the calendar given by jdn.
+func dateInCalendar(n int, jdn func(y, m, d int) int) Date {
+	y := int(math.Floor(float64(n-1721060) / 365.25))
+	for jdn(y+1, 1, 1) <= n {
+		y++
+	}
+	for jdn(y, 1, 1) > n {
+		y--
+	}
+	m := 12
+	for jdn(y, m, 1) > n {
+		m--
+	}
+	return Date{y, m, n - jdn(y, m, 1) + 1}
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+func floorMod(a, b int) int {
+	return a - b*floorDiv(a, b)
+}
 
 type JulianDay float64
 
@@ -43,20 +219,42 @@ type Time interface {
 
 // Ch 7 p.60
 func MakeJulianDay(t Time) JulianDay {
-	y, m, d := t.Date().Year, t.Date().Month, t.Date().Day
-	if m <= 2 {
-		y--
-		m += 12
-	}
-	b := 0
-	if t.Date().compareTo(gregorianStart) >= 0 {
-		a := y / 100
-		b = 2 - a + (a / 4)
-	}
-	fd := float64(d) + t.Hours()/24
-	jdint := int(365.25*float64(y+4716)) + int(30.6001*float64(m+1)) + b
-	jd := float64(jdint) + fd - 1524.5
-	return JulianDay(jd)
+	return MakePreciseJulianDay(t).JulianDay()
+}
+
+// Ch 7 p.63
+// Returns the calendar date and hours (UT or TD, whichever the Julian Day
+// is in) of jd.
+func (jd JulianDay) calendarDate() (Date, float64) {
+	Z := math.Floor(float64(jd) + 0.5)
+	return dateFromJDN(int(Z)), (float64(jd) + 0.5 - Z) * 24
+}
+
+// Returns the calendar date on which jd falls.
+func (jd JulianDay) Date() Date {
+	d, _ := jd.calendarDate()
+	return d
+}
+
+// Interprets jd as a Julian Day in Universal Time.
+func (jd JulianDay) UT() UT {
+	d, h := jd.calendarDate()
+	return UT{d, h}
+}
+
+// Interprets jd as a Julian Ephemeris Day (JDE).
+func (jd JulianDay) TD() TD {
+	d, h := jd.calendarDate()
+	return TD{d, h}
+}
+
+func (jd JulianDay) AddDays(n float64) JulianDay {
+	return jd + JulianDay(n)
+}
+
+// Returns jd - jd2 in days.
+func (jd JulianDay) Sub(jd2 JulianDay) float64 {
+	return float64(jd - jd2)
 }
 
 func DayOfYear(date time.Time) int {
@@ -34,7 +34,7 @@ func TestJulianDay(t *testing.T) {
         {1600, 12, 31, 2305812.5},
         {837, 4, 10.3, 2026871.8},
         {-1000, 7, 12.5, 1356001},
-        //{-1000, 2, 29, 1355866.5}, // a leap year acc to julian but not gregorian?
+        {-1000, 2, 29, 1355866.5}, // a leap year in the Julian calendar
         {-1001, 8, 17.9, 1355671.4},
         {-4712, 1, 1.5, 0},
     }
@@ -66,3 +66,152 @@ func TestDayOfYear(t *testing.T) {
         }
     }
 }
+
+func TestJulianDayToDate(t *testing.T) {
+    cases := []struct {
+        jd float64
+        y, m int
+        d float64
+    }{
+        {2436116.31, 1957, 10, 4.81},
+        {1842713, 333, 1, 27.5},
+        {1507900.13, -584, 5, 28.63},
+        {2451545, 2000, 1, 1.5},
+        {2299160.5, 1582, 10, 15},
+        {2299159.5, 1582, 10, 4},
+        {0, -4712, 1, 1.5},
+    }
+
+    for _, c := range cases {
+        d, df := math.Modf(c.d)
+        got := JulianDay(c.jd).UT()
+        want := UT{Date{c.y, c.m, int(d)}, 24*df}
+        if got.date != want.date || math.Abs(got.hours - want.hours) > 1e-6 {
+            t.Errorf("JulianDay(%f).UT() = %v, want %v", c.jd, got, want)
+        }
+    }
+}
+
+func TestJulianDayArithmetic(t *testing.T) {
+    // Ch 7 p.64: Halley's Comet passed perihelion 1835 Nov 16 and 1910 Apr 20.
+    jd1 := MakeJulianDay(UT{Date{1835, 11, 16}, 0})
+    jd2 := MakeJulianDay(UT{Date{1910, 4, 20}, 0})
+    if got := jd2.Sub(jd1); got != 27183 {
+        t.Errorf("%f.Sub(%f) = %f, want 27183", jd2, jd1, got)
+    }
+    // Ch 7 p.64: 10000 days after 1954 Jun 30.
+    jd := MakeJulianDay(UT{Date{1954, 6, 30}, 0}).AddDays(10000)
+    if got, want := jd.Date(), (Date{1981, 11, 15}); got != want {
+        t.Errorf("%f.Date() = %v, want %v", jd, got, want)
+    }
+    td := jd.AddDays(0.25).TD()
+    if want := (TD{Date{1981, 11, 15}, 6}); td != want {
+        t.Errorf("%f.TD() = %v, want %v", jd.AddDays(0.25), td, want)
+    }
+}
+
+func TestDateAddDays(t *testing.T) {
+    cases := []struct {
+        d Date
+        n int
+        want Date
+    }{
+        {Date{1582, 10, 4}, 1, Date{1582, 10, 15}},
+        {Date{1582, 10, 15}, -1, Date{1582, 10, 4}},
+        {Date{1500, 2, 28}, 1, Date{1500, 2, 29}},
+        {Date{1, 1, 1}, -1, Date{0, 12, 31}},
+        {Date{0, 2, 28}, 1, Date{0, 2, 29}},
+        {Date{-1000, 7, 12}, 366, Date{-999, 7, 13}},
+        {Date{2000, 2, 28}, 2, Date{2000, 3, 1}},
+        {Date{1954, 6, 30}, 10000, Date{1981, 11, 15}},
+    }
+
+    for _, c := range cases {
+        got := c.d.AddDays(c.n)
+        if got != c.want {
+            t.Errorf("%v.AddDays(%d) = %v, want %v", c.d, c.n, got, c.want)
+        }
+        if back := got.Sub(c.d); back != c.n {
+            t.Errorf("%v.Sub(%v) = %d, want %d", got, c.d, back, c.n)
+        }
+    }
+}
+
+func TestDateCalendar(t *testing.T) {
+    if d := (Date{1582, 10, 10}); d.IsValid() {
+        t.Errorf("%v.IsValid() = true, want false", d)
+    }
+    if d := (Date{1700, 2, 29}); d.IsValid() {
+        t.Errorf("%v.IsValid() = true, want false", d)
+    }
+
+    // Ch 7 p.65
+    if got := (Date{1954, 6, 30}).Weekday(); got != time.Wednesday {
+        t.Errorf("Weekday() = %v, want Wednesday", got)
+    }
+    if got := (Date{1582, 10, 4}).Weekday(); got != time.Thursday {
+        t.Errorf("Weekday() = %v, want Thursday", got)
+    }
+
+    // Ch 7 p.62
+    for _, y := range []int{900, 1236, 1600, 2000, 0, -4} {
+        if !IsLeapYear(y) {
+            t.Errorf("IsLeapYear(%d) = false, want true", y)
+        }
+    }
+    for _, y := range []int{750, 1429, 1700, 1800, 1900, 2100, -1} {
+        if IsLeapYear(y) {
+            t.Errorf("IsLeapYear(%d) = true, want false", y)
+        }
+    }
+
+    // Ch 7 p.65
+    if got := (Date{1988, 4, 22}).DayOfYear(); got != 113 {
+        t.Errorf("DayOfYear() = %d, want 113", got)
+    }
+    if got := DaysInMonth(1582, 10); got != 21 {
+        t.Errorf("DaysInMonth(1582, 10) = %d, want 21", got)
+    }
+
+    // time.Time is proleptic Gregorian.
+    d := Date{1582, 10, 4}
+    if got, want := d.Time(), date(1582, 10, 14); !got.Equal(want) {
+        t.Errorf("%v.Time() = %v, want %v", d, got, want)
+    }
+    if got := MakeDate(date(1582, 10, 14)); got != d {
+        t.Errorf("MakeDate(1582-10-14) = %v, want %v", got, d)
+    }
+
+    if !(Date{-1, 12, 31}).Before(Date{0, 1, 1}) || (Date{0, 1, 1}).Compare(Date{0, 1, 1}) != 0 {
+        t.Error("Date comparison is wrong around year 0")
+    }
+}
+
+func TestGregorianReform(t *testing.T) {
+    defer SetGregorianReform(GregorianReform())
+    if err := SetGregorianReform(Date{1752, 9, 14}); err != nil {
+        t.Fatal(err)
+    }
+
+    if got, want := (Date{1752, 9, 2}).AddDays(1), (Date{1752, 9, 14}); got != want {
+        t.Errorf("AddDays(1) = %v, want %v", got, want)
+    }
+    if !IsLeapYear(1700) {
+        t.Error("IsLeapYear(1700) = false, want true")
+    }
+    jd := MakeJulianDay(UT{Date{1700, 3, 1}, 0})
+    if got, want := jd.Date(), (Date{1700, 3, 1}); got != want {
+        t.Errorf("JulianDay(%f).Date() = %v, want %v", jd, got, want)
+    }
+}
+
+func TestSetGregorianReformInvalid(t *testing.T) {
+    for _, d := range []Date{{1752, 9, 31}, {1752, 13, 1}, {1582, 10, 14}, {1500, 1, 1}} {
+        if err := SetGregorianReform(d); err == nil {
+            t.Errorf("SetGregorianReform(%v) succeeded", d)
+        }
+    }
+    if got, want := GregorianReform(), (Date{1582, 10, 15}); got != want {
+        t.Errorf("GregorianReform() = %v after invalid sets, want %v", got, want)
+    }
+}
@@ -0,0 +1,222 @@
+package goastro
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// A DeltaTEntry gives ΔT (seconds) at a decimal year.
+type DeltaTEntry struct {
+	Year   float64
+	DeltaT float64
+}
+
+// A DeltaTTable is a list of ΔT values in increasing order of year.
+// Values between entries are interpolated linearly.
+type DeltaTTable []DeltaTEntry
+
+var ErrDeltaTRange = errors.New("DeltaT: year outside table")
+
+// Returns ΔT for the decimal year y, or ErrDeltaTRange if y is not
+// covered by the table.
+func (t DeltaTTable) DeltaT(y float64) (float64, error) {
+	if len(t) == 0 || y < t[0].Year || y > t[len(t)-1].Year {
+		return 0, ErrDeltaTRange
+	}
+	i := sort.Search(len(t), func(i int) bool { return t[i].Year >= y })
+	if t[i].Year == y {
+		return t[i].DeltaT, nil
+	}
+	a, b := t[i-1], t[i]
+	return a.DeltaT + (b.DeltaT-a.DeltaT)*(y-a.Year)/(b.Year-a.Year), nil
+}
+
+func (t DeltaTTable) validate() error {
+	for i := 1; i < len(t); i++ {
+		if !(t[i].Year > t[i-1].Year) {
+			return fmt.Errorf("DeltaT table: year %v out of order", t[i].Year)
+		}
+	}
+	return nil
+}
+
+// Observed ΔT, 1620-1998 from Table 10.A (Ch 10 p.79), later values from
+// IERS.
+var observedDeltaT = DeltaTTable{
+	{1620, 121}, {1622, 112}, {1624, 103}, {1626, 95}, {1628, 88},
+	{1630, 82}, {1632, 77}, {1634, 72}, {1636, 68}, {1638, 63},
+	{1640, 60}, {1642, 56}, {1644, 53}, {1646, 51}, {1648, 48},
+	{1650, 46}, {1652, 44}, {1654, 42}, {1656, 40}, {1658, 38},
+	{1660, 35}, {1662, 33}, {1664, 31}, {1666, 29}, {1668, 26},
+	{1670, 24}, {1672, 22}, {1674, 20}, {1676, 18}, {1678, 16},
+	{1680, 14}, {1682, 12}, {1684, 11}, {1686, 10}, {1688, 9},
+	{1690, 8}, {1692, 7}, {1694, 7}, {1696, 7}, {1698, 7},
+	{1700, 7}, {1702, 7}, {1704, 8}, {1706, 8}, {1708, 9},
+	{1710, 9}, {1712, 9}, {1714, 9}, {1716, 9}, {1718, 10},
+	{1720, 10}, {1722, 10}, {1724, 10}, {1726, 10}, {1728, 10},
+	{1730, 10}, {1732, 10}, {1734, 11}, {1736, 11}, {1738, 11},
+	{1740, 11}, {1742, 11}, {1744, 12}, {1746, 12}, {1748, 12},
+	{1750, 12}, {1752, 13}, {1754, 13}, {1756, 13}, {1758, 14},
+	{1760, 14}, {1762, 14}, {1764, 14}, {1766, 15}, {1768, 15},
+	{1770, 15}, {1772, 15}, {1774, 15}, {1776, 16}, {1778, 16},
+	{1780, 16}, {1782, 16}, {1784, 16}, {1786, 16}, {1788, 16},
+	{1790, 16}, {1792, 15}, {1794, 15}, {1796, 14}, {1798, 13},
+	{1800, 13.1}, {1802, 12.5}, {1804, 12.2}, {1806, 12.0}, {1808, 12.0},
+	{1810, 12.0}, {1812, 12.0}, {1814, 12.0}, {1816, 12.0}, {1818, 11.9},
+	{1820, 11.6}, {1822, 11.0}, {1824, 10.2}, {1826, 9.2}, {1828, 8.2},
+	{1830, 7.1}, {1832, 6.2}, {1834, 5.6}, {1836, 5.4}, {1838, 5.3},
+	{1840, 5.4}, {1842, 5.6}, {1844, 5.9}, {1846, 6.2}, {1848, 6.5},
+	{1850, 6.8}, {1852, 7.1}, {1854, 7.3}, {1856, 7.5}, {1858, 7.6},
+	{1860, 7.7}, {1862, 7.3}, {1864, 6.2}, {1866, 5.2}, {1868, 2.7},
+	{1870, 1.4}, {1872, -1.2}, {1874, -2.8}, {1876, -3.8}, {1878, -4.8},
+	{1880, -5.5}, {1882, -5.3}, {1884, -5.6}, {1886, -5.7}, {1888, -5.9},
+	{1890, -6.0}, {1892, -6.3}, {1894, -6.5}, {1896, -6.2}, {1898, -4.7},
+	{1900, -2.8}, {1902, -0.1}, {1904, 2.6}, {1906, 5.3}, {1908, 7.7},
+	{1910, 10.4}, {1912, 13.3}, {1914, 16.0}, {1916, 18.2}, {1918, 20.2},
+	{1920, 21.1}, {1922, 22.4}, {1924, 23.5}, {1926, 23.8}, {1928, 24.3},
+	{1930, 24.0}, {1932, 23.9}, {1934, 23.9}, {1936, 23.7}, {1938, 24.0},
+	{1940, 24.3}, {1942, 25.3}, {1944, 26.2}, {1946, 27.3}, {1948, 28.2},
+	{1950, 29.1}, {1952, 30.0}, {1954, 30.7}, {1956, 31.4}, {1958, 32.2},
+	{1960, 33.1}, {1962, 34.0}, {1964, 35.0}, {1966, 36.5}, {1968, 38.3},
+	{1970, 40.2}, {1972, 42.2}, {1974, 44.5}, {1976, 46.5}, {1978, 48.5},
+	{1980, 50.5}, {1982, 52.2}, {1984, 53.8}, {1986, 54.9}, {1988, 55.8},
+	{1990, 56.9}, {1992, 58.3}, {1994, 60.0}, {1996, 61.6}, {1998, 63.0},
+	{2000, 63.8}, {2002, 64.3}, {2004, 64.6}, {2006, 64.8}, {2008, 65.5},
+	{2010, 66.1}, {2012, 66.6}, {2014, 67.3}, {2016, 68.1}, {2018, 68.97},
+	{2020, 69.36}, {2022, 69.29}, {2024, 69.18},
+}
+
+// Years over which the polynomials are blended into the observed table
+const (
+	deltaTFadeIn  = 1600
+	deltaTFadeOut = 2050
+)
+
+var (
+	userDeltaTMu sync.RWMutex
+	userDeltaT   DeltaTTable
+)
+
+// Installs a table of ΔT values (observed or predicted) that takes
+// precedence over the built-in model for the years it covers. A nil table
+// restores the built-in model.
+//
+// The table is not blended into the model: ΔT steps from the model's
+// value to the table's at its first and last entries, so a table should
+// agree with the model there, or extend beyond the years of interest.
+func SetDeltaTTable(t DeltaTTable) error {
+	if err := t.validate(); err != nil {
+		return err
+	}
+	t = append(DeltaTTable(nil), t...)
+	userDeltaTMu.Lock()
+	defer userDeltaTMu.Unlock()
+	userDeltaT = t
+	return nil
+}
+
+func currentDeltaTTable() DeltaTTable {
+	userDeltaTMu.RLock()
+	defer userDeltaTMu.RUnlock()
+	return userDeltaT
+}
+
+// Year as used by the ΔT polynomials: the middle of the given day.
+func deltaTYear(d Date) float64 {
+	yearLen := Date{d.Year + 1, 1, 1}.Sub(Date{d.Year, 1, 1})
+	return float64(d.Year) + (float64(d.DayOfYear())-0.5)/float64(yearLen)
+}
+
+// ΔT = TD - UT (unit = seconds)
+// Chapter 10 p.80
+func DeltaT(d Date) float64 {
+	ΔT, err := LookupDeltaT(d)
+	if err != nil {
+		return polynomialDeltaT(float64(d.Year))
+	}
+	return ΔT
+}
+
+// Like DeltaT, but reports an error for an invalid date where DeltaT
+// falls back to the polynomial for its year. Any valid date succeeds:
+// uses the table given to SetDeltaTTable, then the observed values, then
+// the Espenak-Meeus polynomials.
+func LookupDeltaT(d Date) (float64, error) {
+	if !d.IsValid() {
+		return 0, fmt.Errorf("DeltaT: invalid date %v", d)
+	}
+	y := deltaTYear(d)
+	if ΔT, err := currentDeltaTTable().DeltaT(y); err == nil {
+		return ΔT, nil
+	}
+	if ΔT, err := observedDeltaT.DeltaT(y); err == nil {
+		return ΔT, nil
+	}
+	ΔT := polynomialDeltaT(y)
+	// Fade in the jump between the polynomial and the first observation,
+	// and fade out the one between the last observation and the
+	// polynomial, so that ΔT is continuous.
+	first := observedDeltaT[0]
+	if y < first.Year && y > deltaTFadeIn {
+		jump := first.DeltaT - polynomialDeltaT(first.Year)
+		ΔT += jump * (y - deltaTFadeIn) / (first.Year - deltaTFadeIn)
+	}
+	last := observedDeltaT[len(observedDeltaT)-1]
+	if y > last.Year && y < deltaTFadeOut {
+		jump := last.DeltaT - polynomialDeltaT(last.Year)
+		ΔT += jump * (deltaTFadeOut - y) / (deltaTFadeOut - last.Year)
+	}
+	return ΔT, nil
+}
+
+// Espenak & Meeus, "Five Millennium Canon of Solar Eclipses" (2006)
+func polynomialDeltaT(y float64) float64 {
+	longTerm := func(y float64) float64 {
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	}
+	switch {
+	case y < -500:
+		return longTerm(y)
+	case y < 500:
+		u := y / 100
+		return poly(u, 10583.6, -1014.41, 33.78311, -5.952053, -0.1798452, 0.022174192, 0.0090316521)
+	case y < 1600:
+		u := (y - 1000) / 100
+		return poly(u, 1574.2, -556.01, 71.23472, 0.319781, -0.8503463, -0.005050998, 0.0083572073)
+	case y < 1700:
+		return poly(y-1600, 120, -0.9808, -0.01532, 1/7129.)
+	case y < 1800:
+		return poly(y-1700, 8.83, 0.1603, -0.0059285, 0.00013336, -1/1174000.)
+	case y < 1860:
+		return poly(y-1800, 13.72, -0.332447, 0.0068612, 0.0041116, -0.00037436, 0.0000121272, -0.0000001699, 0.000000000875)
+	case y < 1900:
+		return poly(y-1860, 7.62, 0.5737, -0.251754, 0.01680668, -0.0004473624, 1/233174.)
+	case y < 1920:
+		return poly(y-1900, -2.79, 1.494119, -0.0598939, 0.0061966, -0.000197)
+	case y < 1941:
+		return poly(y-1920, 21.20, 0.84493, -0.076100, 0.0020936)
+	case y < 1961:
+		return poly(y-1950, 29.07, 0.407, -1/233., 1/2547.)
+	case y < 1986:
+		return poly(y-1975, 45.45, 1.067, -1/260., -1/718.)
+	case y < 2005:
+		return poly(y-2000, 63.86, 0.3345, -0.060374, 0.0017275, 0.000651814, 0.00002373599)
+	case y < 2050:
+		return poly(y-2000, 62.92, 0.32217, 0.005589)
+	case y < 2150:
+		return longTerm(y) - 0.5628*(2150-y)
+	}
+	return longTerm(y)
+}
+
+// Evaluates c[0] + c[1]x + c[2]x² + ...
+func poly(x float64, c ...float64) float64 {
+	r := 0.0
+	for i := len(c) - 1; i >= 0; i-- {
+		r = r*x + c[i]
+	}
+	return r
+}
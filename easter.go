@@ -0,0 +1,95 @@
+package goastro
+
+// Ch 8 p.67
+// Gregorian Easter Sunday. Before GregorianReform() the result is converted
+// to the Julian calendar, like every Date.
+func GregorianEaster(year int) Date {
+	a := floorMod(year, 19)
+	b := floorDiv(year, 100)
+	c := floorMod(year, 100)
+	d := floorDiv(b, 4)
+	e := floorMod(b, 4)
+	f := floorDiv(b+8, 25)
+	g := floorDiv(b-f+1, 3)
+	h := floorMod(19*a+b-d-g+15, 30)
+	i := c / 4
+	k := c % 4
+	l := floorMod(32+2*e+2*i-h-k, 7)
+	m := (a + 11*h + 22*l) / 451
+	n := h + l - 7*m + 114
+	return dateFromJDN(gregorianJDN(year, n/31, n%31+1))
+}
+
+// Ch 8 p.69
+// Easter Sunday in the Julian calendar, as still used by the Orthodox
+// churches. From GregorianReform() on the result is converted to the
+// Gregorian calendar, like every Date.
+func JulianEaster(year int) Date {
+	a := floorMod(year, 4)
+	b := floorMod(year, 7)
+	c := floorMod(year, 19)
+	d := (19*c + 15) % 30
+	e := (2*a + 4*b - d + 34) % 7
+	n := d + e + 114
+	return dateFromJDN(julianJDN(year, n/31, n%31+1))
+}
+
+// Easter Sunday according to the calendar in force in the given year.
+func Easter(year int) Date {
+	if (Date{year, 3, 1}).IsGregorian() {
+		return GregorianEaster(year)
+	}
+	return JulianEaster(year)
+}
+
+// A movable feast, Offset days after Easter Sunday.
+type Feast struct {
+	Name   string
+	Offset int
+}
+
+func (f Feast) Date(easter Date) Date {
+	return easter.AddDays(f.Offset)
+}
+
+type FeastDate struct {
+	Name string
+	Date Date
+}
+
+var WesternFeasts = []Feast{
+	{"Septuagesima", -63},
+	{"Shrove Tuesday", -47},
+	{"Ash Wednesday", -46},
+	{"Palm Sunday", -7},
+	{"Maundy Thursday", -3},
+	{"Good Friday", -2},
+	{"Easter", 0},
+	{"Ascension", 39},
+	{"Pentecost", 49},
+	{"Trinity Sunday", 56},
+	{"Corpus Christi", 60},
+	{"Sacred Heart", 68},
+}
+
+var OrthodoxFeasts = []Feast{
+	{"Clean Monday", -48},
+	{"Lazarus Saturday", -8},
+	{"Palm Sunday", -7},
+	{"Holy Friday", -2},
+	{"Pascha", 0},
+	{"Thomas Sunday", 7},
+	{"Ascension", 39},
+	{"Pentecost", 49},
+	{"All Saints", 56},
+}
+
+// Returns the dates of feasts in the year of the given Easter Sunday,
+// e.g. MovableFeasts(GregorianEaster(2024), WesternFeasts).
+func MovableFeasts(easter Date, feasts []Feast) []FeastDate {
+	dates := make([]FeastDate, len(feasts))
+	for i, f := range feasts {
+		dates[i] = FeastDate{f.Name, f.Date(easter)}
+	}
+	return dates
+}
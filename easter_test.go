@@ -0,0 +1,77 @@
+package goastro
+
+import (
+	"testing"
+)
+
+func TestGregorianEaster(t *testing.T) {
+	cases := []struct {
+		year int
+		want Date
+	}{
+		{1991, Date{1991, 3, 31}},
+		{1992, Date{1992, 4, 19}},
+		{1993, Date{1993, 4, 11}},
+		{1954, Date{1954, 4, 18}},
+		{2000, Date{2000, 4, 23}},
+		{1818, Date{1818, 3, 22}},
+		{2285, Date{2285, 3, 22}},
+		{1886, Date{1886, 4, 25}},
+		{1943, Date{1943, 4, 25}},
+		{2038, Date{2038, 4, 25}},
+	}
+
+	for _, c := range cases {
+		got := GregorianEaster(c.year)
+		if got != c.want {
+			t.Errorf("GregorianEaster(%d) == %v, want %v", c.year, got, c.want)
+		}
+		if got.Weekday() != 0 {
+			t.Errorf("GregorianEaster(%d) == %v is not a Sunday", c.year, got)
+		}
+	}
+}
+
+func TestJulianEaster(t *testing.T) {
+	cases := []struct {
+		year int
+		want Date
+	}{
+		{179, Date{179, 4, 12}},
+		{711, Date{711, 4, 12}},
+		{1243, Date{1243, 4, 12}},
+		// Orthodox Easter, converted to Gregorian dates
+		{2023, Date{2023, 4, 16}},
+		{2024, Date{2024, 5, 5}},
+		{2025, Date{2025, 4, 20}},
+	}
+
+	for _, c := range cases {
+		got := JulianEaster(c.year)
+		if got != c.want {
+			t.Errorf("JulianEaster(%d) == %v, want %v", c.year, got, c.want)
+		}
+	}
+	if got, want := Easter(711), JulianEaster(711); got != want {
+		t.Errorf("Easter(711) == %v, want %v", got, want)
+	}
+}
+
+func TestMovableFeasts(t *testing.T) {
+	got := MovableFeasts(GregorianEaster(2024), WesternFeasts)
+	want := map[string]Date{
+		"Ash Wednesday": {2024, 2, 14},
+		"Easter":        {2024, 3, 31},
+		"Ascension":     {2024, 5, 9},
+		"Pentecost":     {2024, 5, 19},
+	}
+	for _, f := range got {
+		if d, ok := want[f.Name]; ok && d != f.Date {
+			t.Errorf("%s 2024 == %v, want %v", f.Name, f.Date, d)
+		}
+	}
+	custom := Feast{"Rogation Monday", 36}
+	if got, want := custom.Date(GregorianEaster(2024)), (Date{2024, 5, 6}); got != want {
+		t.Errorf("Rogation Monday 2024 == %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,164 @@
+package goastro
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Earth orientation parameters for an instant, as published by the IERS.
+type EOP struct {
+	MJD float64 // Modified Julian Day (UTC)
+	// Polar motion. Only reported: no computation in this package
+	// corrects for it.
+	PolarX, PolarY Angle
+	UT1MinusUTC    float64 // seconds
+	LOD            float64 // excess length of day, seconds
+	Predicted      bool    // UT1-UTC is a prediction rather than a measurement
+}
+
+// Daily Earth orientation parameters, e.g. from a finals2000A file.
+type EOPTable struct {
+	entries []EOP
+}
+
+var ErrEOPRange = errors.New("EOP: date outside table")
+
+// Parses an IERS finals2000A.all / finals2000A.daily file (Bulletin A
+// values). Lines without UT1-UTC, i.e. past the end of the predictions,
+// are skipped.
+func ParseEOP(r io.Reader) (*EOPTable, error) {
+	t := &EOPTable{}
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		text := s.Text()
+		if strings.TrimSpace(column(text, 58, 68)) == "" {
+			continue
+		}
+		var e EOP
+		var err error
+		field := func(from, to int) float64 {
+			if err != nil {
+				return 0
+			}
+			var v float64
+			if f := strings.TrimSpace(column(text, from, to)); f != "" {
+				v, err = strconv.ParseFloat(f, 64)
+			}
+			return v
+		}
+		e.MJD = field(7, 15)
+		e.PolarX = ArcSeconds(field(18, 27))
+		e.PolarY = ArcSeconds(field(37, 46))
+		e.UT1MinusUTC = field(58, 68)
+		e.LOD = field(79, 86) / 1000
+		e.Predicted = column(text, 57, 58) == "P"
+		if err != nil {
+			return nil, fmt.Errorf("EOP line %d: %v", line, err)
+		}
+		if n := len(t.entries); n > 0 && e.MJD <= t.entries[n-1].MJD {
+			return nil, fmt.Errorf("EOP line %d: MJD %v out of order", line, e.MJD)
+		}
+		t.entries = append(t.entries, e)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if len(t.entries) == 0 {
+		return nil, errors.New("EOP: no entries")
+	}
+	return t, nil
+}
+
+// Returns s[from:to], clipped to the length of s.
+func column(s string, from, to int) string {
+	if from >= len(s) {
+		return ""
+	}
+	if to > len(s) {
+		to = len(s)
+	}
+	return s[from:to]
+}
+
+// Reads a finals2000A file from disk.
+func LoadEOP(path string) (*EOPTable, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseEOP(f)
+}
+
+// Interpolates linearly between the daily values. UT1-UTC is interpolated
+// as UT1-TAI so that leap seconds don't disturb it.
+func (t *EOPTable) At(u UTC) (EOP, error) {
+	m := MakePreciseJulianDay(u).MJD()
+	n := len(t.entries)
+	if m < t.entries[0].MJD || m > t.entries[n-1].MJD {
+		return EOP{}, ErrEOPRange
+	}
+	i := sort.Search(n, func(i int) bool { return t.entries[i].MJD > m })
+	if i == n {
+		return t.entries[n-1], nil
+	}
+	a, b := t.entries[i-1], t.entries[i]
+	f := (m - a.MJD) / (b.MJD - a.MJD)
+	lerp := func(x, y float64) float64 { return x + f*(y-x) }
+	ut1TAI := lerp(a.UT1MinusUTC-taiMinusUTCAtMJD(a.MJD), b.UT1MinusUTC-taiMinusUTCAtMJD(b.MJD))
+	offset, _ := TAIMinusUTC(u.date)
+	return EOP{
+		MJD:         m,
+		PolarX:      Angle(lerp(float64(a.PolarX), float64(b.PolarX))),
+		PolarY:      Angle(lerp(float64(a.PolarY), float64(b.PolarY))),
+		UT1MinusUTC: ut1TAI + float64(offset),
+		LOD:         lerp(a.LOD, b.LOD),
+		Predicted:   a.Predicted || b.Predicted,
+	}, nil
+}
+
+func taiMinusUTCAtMJD(m float64) float64 {
+	offset, _ := TAIMinusUTC((MJDEpoch + JulianDay(m)).Date())
+	return float64(offset)
+}
+
+var (
+	eopMu    sync.RWMutex
+	eopTable *EOPTable
+)
+
+// Makes UTC.UT, MakeUT and GreenwichSiderealTime use t for UT1-UTC. A nil
+// table reverts to deriving UT from ΔT.
+func SetEOP(t *EOPTable) {
+	eopMu.Lock()
+	defer eopMu.Unlock()
+	eopTable = t
+}
+
+// Returns UT1-UTC in seconds from the table given to SetEOP.
+func UT1MinusUTC(u UTC) (float64, bool) {
+	eopMu.RLock()
+	table := eopTable
+	eopMu.RUnlock()
+	if table == nil {
+		return 0, false
+	}
+	e, err := table.At(u)
+	if err != nil {
+		return 0, false
+	}
+	return e.UT1MinusUTC, true
+}
+
+// Apparent sidereal time at Greenwich for a UTC instant, using UT1-UTC
+// from the table given to SetEOP if it covers u.
+func GreenwichSiderealTime(u UTC) Angle {
+	return ApparentSiderealTime(u.UT())
+}
@@ -0,0 +1,107 @@
+package goastro
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+const finals2000A = `161230 57752.00 I  0.074200 0.000091  0.282600 0.000087  I-0.4070000 0.0000075  1.0012 0.0041
+161231 57753.00 I  0.073900 0.000091  0.284300 0.000087  I-0.4080000 0.0000075  0.9878 0.0041
+17 1 1 57754.00 I  0.073600 0.000091  0.286000 0.000087  I 0.5910000 0.0000075  1.0114 0.0041
+17 1 2 57755.00 P  0.073300 0.000091  0.287700 0.000087  P 0.5899000 0.0000075  1.0852 0.0041
+17 1 3 57756.00
+`
+
+func TestParseEOP(t *testing.T) {
+	table, err := ParseEOP(strings.NewReader(finals2000A))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(table.entries) != 4 {
+		t.Fatalf("ParseEOP() returned %d entries, want 4", len(table.entries))
+	}
+	e := table.entries[3]
+	if e.MJD != 57755 || e.UT1MinusUTC != 0.5899 || !e.Predicted {
+		t.Errorf("ParseEOP() entry 3 == %+v", e)
+	}
+	if math.Abs(e.PolarY.ArcSeconds()-0.2877) > 1e-9 || math.Abs(e.LOD-0.0010852) > 1e-12 {
+		t.Errorf("ParseEOP() entry 3 == %+v", e)
+	}
+}
+
+func TestEOPAt(t *testing.T) {
+	table, err := ParseEOP(strings.NewReader(finals2000A))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		utc    UTC
+		dut1   float64
+		polarX float64
+	}{
+		{UTC{Date{2016, 12, 31}, 0}, -0.408, 0.0739},
+		// Interpolated across the leap second
+		{UTC{Date{2016, 12, 31}, 12}, -0.4085, 0.07375},
+		{UTC{Date{2017, 1, 1}, 6}, 0.590725, 0.073525},
+	}
+	for _, c := range cases {
+		got, err := table.At(c.utc)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if math.Abs(got.UT1MinusUTC-c.dut1) > 1e-7 {
+			t.Errorf("At(%v).UT1MinusUTC == %f, want %f", c.utc, got.UT1MinusUTC, c.dut1)
+		}
+		if math.Abs(got.PolarX.ArcSeconds()-c.polarX) > 1e-7 {
+			t.Errorf("At(%v).PolarX == %f\", want %f\"", c.utc, got.PolarX.ArcSeconds(), c.polarX)
+		}
+	}
+	if _, err := table.At(UTC{Date{2017, 2, 1}, 0}); err != ErrEOPRange {
+		t.Errorf("At(outside table) returned %v, want ErrEOPRange", err)
+	}
+}
+
+func TestUTCToUT1WithEOP(t *testing.T) {
+	table, err := ParseEOP(strings.NewReader(finals2000A))
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetEOP(table)
+	defer SetEOP(nil)
+
+	tm := time.Date(2016, 12, 31, 0, 0, 0, 0, time.UTC)
+	want := UT{Date{2016, 12, 30}, 24 - 0.408/3600}
+	for _, got := range []UT{MakeUTC(tm).UT(), MakeUT(tm)} {
+		if !sameInstant(got.date, got.hours, want.date, want.hours) {
+			t.Errorf("UT of %v == %v, want %v", tm, got, want)
+		}
+	}
+	gst := GreenwichSiderealTime(MakeUTC(tm))
+	if diff := (gst - ApparentSiderealTime(want)).ArcSeconds(); math.Abs(diff) > 1e-3 {
+		t.Errorf("GreenwichSiderealTime(%v) off by %f\"", tm, diff)
+	}
+}
+
+func TestUT1ToUTCWithEOP(t *testing.T) {
+	table, err := ParseEOP(strings.NewReader(finals2000A))
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetEOP(table)
+	defer SetEOP(nil)
+
+	for _, u := range []UTC{
+		{Date{2016, 12, 30}, 6},
+		{Date{2016, 12, 31}, 12.5},
+		{Date{2017, 1, 1}, 0.25},
+		{Date{2017, 1, 2}, 18},
+	} {
+		got := u.UT().UTC()
+		if !sameInstant(got.date, got.hours, u.date, u.hours) {
+			t.Errorf("%v.UT().UTC() == %v", u, got)
+		}
+	}
+}
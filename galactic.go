@@ -0,0 +1,77 @@
+package goastro
+
+type GalacticPos struct {
+	Long, Lat Angle
+}
+
+// de Vaucouleurs supergalactic coordinates
+type SupergalacticPos struct {
+	Long, Lat Angle
+}
+
+// A spherical coordinate system given by the position of its north pole
+// in another one, and the longitude in it of the other's north pole.
+type poleSystem struct {
+	poleLong, poleLat Angle
+	longOfPole        Angle
+}
+
+var (
+	// Ch 13 p.94
+	galacticB1950 = poleSystem{Degrees(192.25), Degrees(27.4), Degrees(123)}
+	galacticJ2000 = poleSystem{Degrees(192.85948), Degrees(27.12825), Degrees(122.93192)}
+	// Supergalactic north pole and zero point are at l = 47.37°,
+	// b = +6.32° and l = 137.37°, b = 0°.
+	supergalactic = poleSystem{Degrees(47.37), Degrees(6.32), Degrees(90)}
+)
+
+// Ch 13 p.94
+func (s poleSystem) from(long, lat Angle) (Angle, Angle) {
+	Δ := long - s.poleLong
+	x := atan2(cos(lat)*sin(Δ), sin(lat)*cos(s.poleLat)-cos(lat)*sin(s.poleLat)*cos(Δ))
+	b := asin(sin(lat)*sin(s.poleLat) + cos(lat)*cos(s.poleLat)*cos(Δ))
+	return (s.longOfPole - x).Normalize(), b
+}
+
+// Ch 13 p.94
+func (s poleSystem) to(long, lat Angle) (Angle, Angle) {
+	Δ := s.longOfPole - long
+	y := atan2(cos(lat)*sin(Δ), sin(lat)*cos(s.poleLat)-cos(lat)*sin(s.poleLat)*cos(Δ))
+	δ := asin(sin(lat)*sin(s.poleLat) + cos(lat)*cos(s.poleLat)*cos(Δ))
+	return (s.poleLong + y).Normalize(), δ
+}
+
+// Ch 13 p.94
+// epoch is the equinox p is referred to. B1950 and J2000 use the galactic
+// pole defined for them; other epochs are precessed to J2000 first.
+func (p EquatorialPos) GalacticPos(epoch JulianDay) GalacticPos {
+	system := galacticJ2000
+	if epoch == B1950 {
+		system = galacticB1950
+	} else {
+		p = precess(p, epoch, J2000)
+	}
+	l, b := system.from(p.RA, p.Decl)
+	return GalacticPos{l, b}
+}
+
+// Ch 13 p.94
+// Returns the position referred to the equinox of epoch.
+func (g GalacticPos) EquatorialPos(epoch JulianDay) EquatorialPos {
+	if epoch == B1950 {
+		α, δ := galacticB1950.to(g.Long, g.Lat)
+		return EquatorialPos{α, δ}
+	}
+	α, δ := galacticJ2000.to(g.Long, g.Lat)
+	return precess(EquatorialPos{α, δ}, J2000, epoch)
+}
+
+func (g GalacticPos) SupergalacticPos() SupergalacticPos {
+	l, b := supergalactic.from(g.Long, g.Lat)
+	return SupergalacticPos{l, b}
+}
+
+func (s SupergalacticPos) GalacticPos() GalacticPos {
+	l, b := supergalactic.to(s.Long, s.Lat)
+	return GalacticPos{l, b}
+}
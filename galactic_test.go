@@ -0,0 +1,81 @@
+package goastro
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGalacticPos(t *testing.T) {
+	// Ch 13 p.96: Nova Serpentis 1978
+	eq := EquatorialPos{Hours(17 + ms(48, 59.74)), -Degrees(14 + ms(43, 8.2))}
+	got := eq.GalacticPos(B1950)
+	want := GalacticPos{Degrees(12.9593), Degrees(6.0463)}
+	if math.Abs((got.Long-want.Long).Degrees()) > 0.0001 || math.Abs((got.Lat-want.Lat).Degrees()) > 0.0001 {
+		t.Errorf("%v.GalacticPos(B1950) == %v, want %v", eq, got, want)
+	}
+
+	back := got.EquatorialPos(B1950)
+	if arcSecondDifference(back.RA, eq.RA) > 1e-6 || arcSecondDifference(back.Decl, eq.Decl) > 1e-6 {
+		t.Errorf("%v.EquatorialPos(B1950) == %v, want %v", got, back, eq)
+	}
+}
+
+func TestGalacticPosJ2000(t *testing.T) {
+	cases := []struct {
+		eq   EquatorialPos
+		want GalacticPos
+	}{
+		// Galactic centre, Sgr A* and the north galactic pole
+		{EquatorialPos{Degrees(266.40500), -Degrees(28.93617)}, GalacticPos{0, 0}},
+		{EquatorialPos{Hours(17 + ms(45, 40.04)), -Degrees(29 + ms(0, 28.1))}, GalacticPos{Degrees(359.944), -Degrees(0.046)}},
+		{EquatorialPos{Degrees(192.85948), Degrees(27.12825)}, GalacticPos{Degrees(0), Degrees(90)}},
+	}
+	for _, c := range cases {
+		got := c.eq.GalacticPos(J2000)
+		if math.Abs(got.Lat.Degrees()-c.want.Lat.Degrees()) > 0.001 {
+			t.Errorf("%v.GalacticPos(J2000) == %v, want %v", c.eq, got, c.want)
+		}
+		if c.want.Lat != 90 && math.Abs((got.Long-c.want.Long).Normalize180().Degrees()) > 0.001 {
+			t.Errorf("%v.GalacticPos(J2000) == %v, want %v", c.eq, got, c.want)
+		}
+	}
+
+	// Coordinates referred to another equinox give the same result.
+	eq := EquatorialPos{Degrees(83.822), -Degrees(5.391)}
+	epoch := JulianDay(2460000.5)
+	want := eq.GalacticPos(J2000)
+	got := precess(eq, J2000, epoch).GalacticPos(epoch)
+	if arcSecondDifference(got.Long, want.Long) > 0.001 || arcSecondDifference(got.Lat, want.Lat) > 0.001 {
+		t.Errorf("GalacticPos at %f == %v, want %v", epoch, got, want)
+	}
+	back := want.EquatorialPos(epoch).GalacticPos(epoch)
+	if arcSecondDifference(back.Long, want.Long) > 1e-6 || arcSecondDifference(back.Lat, want.Lat) > 1e-6 {
+		t.Errorf("round trip at %f == %v, want %v", epoch, back, want)
+	}
+}
+
+func TestSupergalacticPos(t *testing.T) {
+	cases := []struct {
+		g    GalacticPos
+		want SupergalacticPos
+	}{
+		{GalacticPos{Degrees(137.37), 0}, SupergalacticPos{0, 0}},
+		{GalacticPos{Degrees(47.37), Degrees(6.32)}, SupergalacticPos{0, Degrees(90)}},
+		{GalacticPos{0, Degrees(90)}, SupergalacticPos{Degrees(90), Degrees(6.32)}},
+	}
+	for _, c := range cases {
+		got := c.g.SupergalacticPos()
+		if math.Abs(got.Lat.Degrees()-c.want.Lat.Degrees()) > 1e-9 {
+			t.Errorf("%v.SupergalacticPos() == %v, want %v", c.g, got, c.want)
+		}
+		if c.want.Lat != 90 && math.Abs((got.Long-c.want.Long).Normalize180().Degrees()) > 1e-9 {
+			t.Errorf("%v.SupergalacticPos() == %v, want %v", c.g, got, c.want)
+		}
+	}
+
+	g := GalacticPos{Degrees(283.8), Degrees(74.5)} // Virgo cluster
+	back := g.SupergalacticPos().GalacticPos()
+	if arcSecondDifference(back.Long, g.Long) > 1e-6 || arcSecondDifference(back.Lat, g.Lat) > 1e-6 {
+		t.Errorf("round trip of %v == %v", g, back)
+	}
+}
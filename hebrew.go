@@ -0,0 +1,189 @@
+package goastro
+
+import (
+	"fmt"
+	"math"
+)
+
+// A date in the (arithmetic) Jewish calendar. Months are numbered from
+// Nisan = 1; the year begins with Tishri = 7, and leap years have a
+// thirteenth month, Adar II. The Jewish day begins at the preceding
+// sunset; conversions refer to the civil day.
+type HebrewDate struct {
+	Year, Month, Day int
+}
+
+const (
+	Nisan = iota + 1
+	Iyyar
+	Sivan
+	Tammuz
+	Av
+	Elul
+	Tishri
+	Heshvan
+	Kislev
+	Tevet
+	Shevat
+	Adar
+	AdarII
+)
+
+var hebrewMonthNames = []string{"", "Nisan", "Iyyar", "Sivan", "Tammuz",
+	"Av", "Elul", "Tishri", "Heshvan", "Kislev", "Tevet", "Shevat", "Adar",
+	"Adar II"}
+
+// Tishri 1, AM 1 (Julian -3760 October 7)
+var hebrewEpoch = julianJDN(-3760, 10, 7)
+
+func (h HebrewDate) String() string {
+	name := "?"
+	if h.Month >= 1 && h.Month < len(hebrewMonthNames) {
+		name = hebrewMonthNames[h.Month]
+		if h.Month == Adar && IsHebrewLeapYear(h.Year) {
+			name = "Adar I"
+		}
+	}
+	return fmt.Sprintf("%d %s %d", h.Day, name, h.Year)
+}
+
+// Ch 9 p.71
+func IsHebrewLeapYear(year int) bool {
+	return floorMod(7*year+1, 19) < 7
+}
+
+// Days from the epoch to the molad of Tishri, with the first
+// postponement rule applied.
+func hebrewElapsedDays(year int) int {
+	months := floorDiv(235*year-234, 19)
+	parts := 12084 + 13753*months
+	days := 29*months + floorDiv(parts, 25920)
+	if floorMod(3*(days+1), 7) < 3 {
+		days++
+	}
+	return days
+}
+
+// The remaining postponements, which keep year lengths in range.
+func hebrewYearDelay(year int) int {
+	ny0 := hebrewElapsedDays(year - 1)
+	ny1 := hebrewElapsedDays(year)
+	ny2 := hebrewElapsedDays(year + 1)
+	if ny2-ny1 == 356 {
+		return 2
+	}
+	if ny1-ny0 == 382 {
+		return 1
+	}
+	return 0
+}
+
+// Julian Day Number of Tishri 1 of the given year.
+func hebrewNewYear(year int) int {
+	return hebrewEpoch + hebrewElapsedDays(year) + hebrewYearDelay(year)
+}
+
+// Ch 9 p.71
+// 353, 354 or 355 days, or 383, 384 or 385 in leap years.
+func HebrewYearLength(year int) int {
+	return hebrewNewYear(year+1) - hebrewNewYear(year)
+}
+
+func HebrewMonthLength(year, month int) int {
+	switch month {
+	case Iyyar, Tammuz, Elul, Tevet, AdarII:
+		return 29
+	case Adar:
+		if !IsHebrewLeapYear(year) {
+			return 29
+		}
+	case Heshvan:
+		if l := HebrewYearLength(year) % 10; l != 5 {
+			return 29
+		}
+	case Kislev:
+		if l := HebrewYearLength(year) % 10; l == 3 {
+			return 29
+		}
+	}
+	return 30
+}
+
+func hebrewMonths(year int) int {
+	if IsHebrewLeapYear(year) {
+		return 13
+	}
+	return 12
+}
+
+func (h HebrewDate) jdn() int {
+	n := hebrewNewYear(h.Year) + h.Day - 1
+	if h.Month < Tishri {
+		for m := Tishri; m <= hebrewMonths(h.Year); m++ {
+			n += HebrewMonthLength(h.Year, m)
+		}
+		for m := Nisan; m < h.Month; m++ {
+			n += HebrewMonthLength(h.Year, m)
+		}
+	} else {
+		for m := Tishri; m < h.Month; m++ {
+			n += HebrewMonthLength(h.Year, m)
+		}
+	}
+	return n
+}
+
+func hebrewDateFromJDN(n int) HebrewDate {
+	year := floorDiv((n-hebrewEpoch)*98496, 35975351) + 1
+	for hebrewNewYear(year) > n {
+		year--
+	}
+	for hebrewNewYear(year+1) <= n {
+		year++
+	}
+	month := Nisan
+	if n < (HebrewDate{year, Nisan, 1}).jdn() {
+		month = Tishri
+	}
+	for n > (HebrewDate{year, month, HebrewMonthLength(year, month)}).jdn() {
+		month++
+	}
+	return HebrewDate{year, month, n - (HebrewDate{year, month, 1}).jdn() + 1}
+}
+
+func MakeHebrewDate(d Date) HebrewDate {
+	return hebrewDateFromJDN(d.jdn())
+}
+
+func (h HebrewDate) Date() Date {
+	return dateFromJDN(h.jdn())
+}
+
+// Julian Day at the start (0h) of the civil day.
+func (h HebrewDate) JulianDay() JulianDay {
+	return JulianDay(float64(h.jdn()) - 0.5)
+}
+
+// Returns the date of the civil day (midnight to midnight) on which jd
+// falls.
+func (jd JulianDay) HebrewDate() HebrewDate {
+	return hebrewDateFromJDN(int(math.Floor(float64(jd) + 0.5)))
+}
+
+// Reports whether the month and day are in range for the year.
+func (h HebrewDate) IsValid() bool {
+	return h.Month >= 1 && h.Month <= hebrewMonths(h.Year) &&
+		h.Day >= 1 && h.Day <= HebrewMonthLength(h.Year, h.Month)
+}
+
+// Ch 9 p.71
+// First day of Passover (Nisan 15) in the given Julian or Gregorian year.
+func Passover(year int) Date {
+	return HebrewDate{year + 3760, Nisan, 15}.Date()
+}
+
+// Ch 9 p.71
+// Jewish New Year (Tishri 1) in the given Julian or Gregorian year.
+func RoshHashanah(year int) Date {
+	return HebrewDate{year + 3761, Tishri, 1}.Date()
+}
@@ -0,0 +1,93 @@
+package goastro
+
+import (
+	"testing"
+)
+
+func TestPassover(t *testing.T) {
+	cases := []struct {
+		year int
+		want Date
+	}{
+		{1990, Date{1990, 4, 10}}, // Ch 9 p.72
+		{2024, Date{2024, 4, 23}},
+		{2025, Date{2025, 4, 13}},
+	}
+	for _, c := range cases {
+		if got := Passover(c.year); got != c.want {
+			t.Errorf("Passover(%d) == %v, want %v", c.year, got, c.want)
+		}
+	}
+}
+
+func TestRoshHashanah(t *testing.T) {
+	cases := []struct {
+		year int
+		want Date
+	}{
+		{1990, Date{1990, 9, 20}},
+		{2023, Date{2023, 9, 16}},
+		{2024, Date{2024, 10, 3}},
+	}
+	for _, c := range cases {
+		if got := RoshHashanah(c.year); got != c.want {
+			t.Errorf("RoshHashanah(%d) == %v, want %v", c.year, got, c.want)
+		}
+	}
+}
+
+func TestHebrewYear(t *testing.T) {
+	if IsHebrewLeapYear(5750) {
+		t.Error("IsHebrewLeapYear(5750) == true")
+	}
+	if got := HebrewYearLength(5750); got != 355 {
+		t.Errorf("HebrewYearLength(5750) == %d, want 355", got)
+	}
+	if !IsHebrewLeapYear(5784) {
+		t.Error("IsHebrewLeapYear(5784) == false")
+	}
+	if got := HebrewYearLength(5784); got != 383 {
+		t.Errorf("HebrewYearLength(5784) == %d, want 383", got)
+	}
+	if got := HebrewMonthLength(5784, Kislev); got != 29 {
+		t.Errorf("HebrewMonthLength(5784, Kislev) == %d, want 29", got)
+	}
+}
+
+func TestHebrewDateRoundTrip(t *testing.T) {
+	cases := []struct {
+		d    Date
+		want HebrewDate
+	}{
+		{Date{2024, 3, 25}, HebrewDate{5784, AdarII, 15}},
+		{Date{1948, 5, 14}, HebrewDate{5708, Iyyar, 5}},
+		{Date{2000, 1, 1}, HebrewDate{5760, Tevet, 23}},
+	}
+	for _, c := range cases {
+		if got := MakeHebrewDate(c.d); got != c.want {
+			t.Errorf("MakeHebrewDate(%v) == %v, want %v", c.d, got, c.want)
+		}
+	}
+
+	d := Date{1582, 1, 1}
+	for i := 0; i < 3000; i++ {
+		h := MakeHebrewDate(d)
+		if !h.IsValid() || h.Date() != d {
+			t.Fatalf("MakeHebrewDate(%v) == %v, which converts back to %v", d, h, h.Date())
+		}
+		d = d.AddDays(97)
+	}
+}
+
+func TestJulianDayHebrewDate(t *testing.T) {
+	h := HebrewDate{5784, AdarII, 15}
+	for _, hours := range []float64{0, 12, 23.9} {
+		jd := h.JulianDay().AddDays(hours / 24)
+		if got := jd.HebrewDate(); got != h {
+			t.Errorf("JulianDay(%f).HebrewDate() == %v, want %v", jd, got, h)
+		}
+	}
+	if got := MakeJulianDay(UT{Date{2000, 1, 1}, 12}).HebrewDate(); got != (HebrewDate{5760, Tevet, 23}) {
+		t.Errorf("HebrewDate() of 2000-01-01 == %v", got)
+	}
+}
@@ -0,0 +1,83 @@
+package goastro
+
+import (
+	"fmt"
+	"math"
+)
+
+// A date in the tabular Islamic calendar (the 30-year cycle with leap
+// years 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29). Actual months begin
+// with the sighting of the crescent and may differ by a day or two. The
+// Islamic day begins at the preceding sunset; conversions refer to the
+// civil day.
+type HijriDate struct {
+	Year, Month, Day int
+}
+
+var hijriMonthNames = []string{"", "Muharram", "Safar", "Rabi' al-awwal",
+	"Rabi' al-thani", "Jumada al-awwal", "Jumada al-thani", "Rajab",
+	"Sha'ban", "Ramadan", "Shawwal", "Dhu al-Qi'dah", "Dhu al-Hijjah"}
+
+// Muharram 1, AH 1 (Julian 622 July 16)
+var hijriEpoch = julianJDN(622, 7, 16)
+
+func (h HijriDate) String() string {
+	name := "?"
+	if h.Month >= 1 && h.Month < len(hijriMonthNames) {
+		name = hijriMonthNames[h.Month]
+	}
+	return fmt.Sprintf("%d %s %d", h.Day, name, h.Year)
+}
+
+// Ch 9 p.73
+func IsHijriLeapYear(year int) bool {
+	return floorMod(14+11*year, 30) < 11
+}
+
+func HijriMonthLength(year, month int) int {
+	if month%2 == 1 || (month == 12 && IsHijriLeapYear(year)) {
+		return 30
+	}
+	return 29
+}
+
+func (h HijriDate) jdn() int {
+	return hijriEpoch - 1 + h.Day + (59*(h.Month-1)+1)/2 +
+		354*(h.Year-1) + floorDiv(3+11*h.Year, 30)
+}
+
+func hijriDateFromJDN(n int) HijriDate {
+	year := floorDiv(30*(n-hijriEpoch)+10646, 10631)
+	month := 1
+	for month < 12 && n >= (HijriDate{year, month + 1, 1}).jdn() {
+		month++
+	}
+	return HijriDate{year, month, n - (HijriDate{year, month, 1}).jdn() + 1}
+}
+
+// Ch 9 p.73
+func MakeHijriDate(d Date) HijriDate {
+	return hijriDateFromJDN(d.jdn())
+}
+
+// Ch 9 p.73
+func (h HijriDate) Date() Date {
+	return dateFromJDN(h.jdn())
+}
+
+// Julian Day at the start (0h) of the civil day.
+func (h HijriDate) JulianDay() JulianDay {
+	return JulianDay(float64(h.jdn()) - 0.5)
+}
+
+// Returns the date of the civil day (midnight to midnight) on which jd
+// falls.
+func (jd JulianDay) HijriDate() HijriDate {
+	return hijriDateFromJDN(int(math.Floor(float64(jd) + 0.5)))
+}
+
+// Reports whether the month and day are in range for the year.
+func (h HijriDate) IsValid() bool {
+	return h.Month >= 1 && h.Month <= 12 &&
+		h.Day >= 1 && h.Day <= HijriMonthLength(h.Year, h.Month)
+}
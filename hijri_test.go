@@ -0,0 +1,61 @@
+package goastro
+
+import (
+	"testing"
+)
+
+func TestHijriDate(t *testing.T) {
+	cases := []struct {
+		d    Date
+		want HijriDate
+	}{
+		{Date{1991, 8, 13}, HijriDate{1412, 2, 2}}, // Ch 9 p.74
+		{Date{2000, 4, 6}, HijriDate{1421, 1, 1}},  // Ch 9 p.75
+		{Date{622, 7, 16}, HijriDate{1, 1, 1}},
+		{Date{2024, 3, 11}, HijriDate{1445, 9, 1}},
+	}
+	for _, c := range cases {
+		d := c.d
+		if got := MakeHijriDate(d); got != c.want {
+			t.Errorf("MakeHijriDate(%v) == %v, want %v", d, got, c.want)
+		}
+		if got := c.want.Date(); got != d {
+			t.Errorf("%v.Date() == %v, want %v", c.want, got, d)
+		}
+	}
+}
+
+func TestHijriYear(t *testing.T) {
+	days := 0
+	for m := 1; m <= 12; m++ {
+		days += HijriMonthLength(1421, m)
+	}
+	if days != 354 || IsHijriLeapYear(1421) {
+		t.Errorf("1421 has %d days, want 354", days)
+	}
+	if !IsHijriLeapYear(1420) || HijriMonthLength(1420, 12) != 30 {
+		t.Error("1420 is not a leap year")
+	}
+
+	d := Date{600, 1, 1}
+	for i := 0; i < 3000; i++ {
+		h := MakeHijriDate(d)
+		if !h.IsValid() || h.Date() != d {
+			t.Fatalf("MakeHijriDate(%v) == %v, which converts back to %v", d, h, h.Date())
+		}
+		d = d.AddDays(191)
+	}
+}
+
+func TestJulianDayHijriDate(t *testing.T) {
+	h := HijriDate{1412, 2, 2}
+	for _, hours := range []float64{0, 12, 23.9} {
+		jd := h.JulianDay().AddDays(hours / 24)
+		if got := jd.HijriDate(); got != h {
+			t.Errorf("JulianDay(%f).HijriDate() == %v, want %v", jd, got, h)
+		}
+	}
+	if got := MakeJulianDay(UT{Date{2000, 4, 6}, 12}).HijriDate(); got != (HijriDate{1421, 1, 1}) {
+		t.Errorf("HijriDate() of 2000-04-06 == %v", got)
+	}
+}
@@ -1,11 +1,295 @@
 package goastro
 
-func Interpolate3(y1, y2, y3, n float64) float64 {
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var ErrInterpolationRange = errors.New("Interpolate: n not in [-1, 1]")
+
+// Ch 3 p.24
+// Interpolates between three equally spaced values, where n is measured
+// from y2 in units of the spacing.
+func Interpolate3(y1, y2, y3, n float64) (float64, error) {
 	if n < -1 || n > 1 {
-		panic("Interpolate: n not in [-1, 1]")
+		return 0, ErrInterpolationRange
 	}
+	return interpolate3(y1, y2, y3, n), nil
+}
+
+func interpolate3(y1, y2, y3, n float64) float64 {
 	a := y2 - y1
 	b := y3 - y2
 	c := b - a
-	return y2 + n * (a + b + n * c) / 2
+	return y2 + n*(a+b+n*c)/2
+}
+
+// Returns the angles as offsets from the middle one, each within ±180°,
+// so that they can be interpolated across 0°/360°.
+func unwrapAngles(as []Angle) (mid Angle, ys []float64) {
+	mid = as[len(as)/2]
+	ys = make([]float64, len(as))
+	for i, a := range as {
+		ys[i] = float64((a - mid).Normalize180())
+	}
+	return mid, ys
+}
+
+// Like Interpolate3, but for angles that may wrap around 360°, such as
+// right ascension. The result is within 180° of a2 and not normalized.
+func InterpolateAngle3(a1, a2, a3 Angle, n float64) (Angle, error) {
+	if n < -1 || n > 1 {
+		return 0, ErrInterpolationRange
+	}
+	mid, ys := unwrapAngles([]Angle{a1, a2, a3})
+	return mid + Angle(interpolate3(ys[0], ys[1], ys[2], n)), nil
+}
+
+var (
+	ErrNoExtremum = errors.New("Interpolate: no extremum in range")
+	ErrNoZero     = errors.New("Interpolate: no zero in range")
+)
+
+// Ch 3 p.28
+func fivePointDifferences(y1, y2, y3, y4, y5 float64) (B, C, F, H, J, K float64) {
+	A := y2 - y1
+	B = y3 - y2
+	C = y4 - y3
+	D := y5 - y4
+	E := B - A
+	F = C - B
+	G := D - C
+	H = F - E
+	J = G - F
+	K = J - H
+	return
+}
+
+func interpolate5(y1, y2, y3, y4, y5, n float64) float64 {
+	B, C, F, H, J, K := fivePointDifferences(y1, y2, y3, y4, y5)
+	n2 := n * n
+	return y3 + n/2*(B+C) + n2/2*F + n*(n2-1)/12*(H+J) + n2*(n2-1)/24*K
+}
+
+// Ch 3 p.28
+// Interpolates between five equally spaced values, where n is measured
+// from y3 in units of the spacing.
+func Interpolate5(y1, y2, y3, y4, y5, n float64) (float64, error) {
+	if n < -2 || n > 2 {
+		return 0, ErrInterpolationRange
+	}
+	return interpolate5(y1, y2, y3, y4, y5, n), nil
+}
+
+// Ch 3 p.25
+// Returns the extremum of the parabola through three equally spaced
+// values, and where it is, measured from y2.
+func Extremum3(y1, y2, y3 float64) (n, y float64, err error) {
+	a := y2 - y1
+	b := y3 - y2
+	c := b - a
+	if c == 0 {
+		return 0, 0, ErrNoExtremum
+	}
+	n = -(a + b) / (2 * c)
+	if n < -1 || n > 1 {
+		return 0, 0, ErrNoExtremum
+	}
+	return n, y2 - (a+b)*(a+b)/(8*c), nil
+}
+
+// Ch 3 p.29
+func Extremum5(y1, y2, y3, y4, y5 float64) (n, y float64, err error) {
+	B, C, F, H, J, K := fivePointDifferences(y1, y2, y3, y4, y5)
+	if K-12*F == 0 {
+		return 0, 0, ErrNoExtremum
+	}
+	for i := 0; i < 50; i++ {
+		n0 := n
+		n = (6*B + 6*C - H - J + 3*n*n*(H+J) + 2*n*n*n*K) / (K - 12*F)
+		if math.Abs(n) > 2 || math.IsNaN(n) {
+			break
+		}
+		if math.Abs(n-n0) < 1e-12 {
+			return n, interpolate5(y1, y2, y3, y4, y5, n), nil
+		}
+	}
+	return 0, 0, ErrNoExtremum
+}
+
+// Ch 3 p.26
+// Returns where the parabola through three equally spaced values crosses
+// zero, measured from y2.
+func Zero3(y1, y2, y3 float64) (float64, error) {
+	a := y2 - y1
+	b := y3 - y2
+	c := b - a
+	n := 0.0
+	for i := 0; i < 50; i++ {
+		n0 := n
+		n = -2 * y2 / (a + b + c*n)
+		if math.Abs(n) > 1 || math.IsNaN(n) || math.IsInf(n, 0) {
+			break
+		}
+		if math.Abs(n-n0) < 1e-12 {
+			return n, nil
+		}
+	}
+	return 0, ErrNoZero
+}
+
+// Ch 3 p.29
+func Zero5(y1, y2, y3, y4, y5 float64) (float64, error) {
+	B, C, F, H, J, K := fivePointDifferences(y1, y2, y3, y4, y5)
+	n := 0.0
+	for i := 0; i < 50; i++ {
+		n0 := n
+		n2 := n * n
+		n = (-24*y3 + n2*(K-12*F) - 2*n2*n*(H+J) - n2*n2*K) / (2 * (6*B + 6*C - H - J))
+		if math.Abs(n) > 2 || math.IsNaN(n) || math.IsInf(n, 0) {
+			break
+		}
+		if math.Abs(n-n0) < 1e-12 {
+			return n, nil
+		}
+	}
+	return 0, ErrNoZero
+}
+
+// Ch 3 p.32
+// Interpolates through the points (x[i], y[i]), which need not be equally
+// spaced.
+func Lagrange(x, y []float64, x0 float64) (float64, error) {
+	if len(x) != len(y) || len(x) == 0 {
+		return 0, errors.New("Lagrange: need equal numbers of x and y values")
+	}
+	v := 0.0
+	for i := range x {
+		c := 1.0
+		for j := range x {
+			if j == i {
+				continue
+			}
+			if x[i] == x[j] {
+				return 0, errors.New("Lagrange: repeated x value")
+			}
+			c *= (x0 - x[j]) / (x[i] - x[j])
+		}
+		v += c * y[i]
+	}
+	return v, nil
+}
+
+// The number of tabulated values used for each interpolation. Five points
+// follow a curve more closely, at the cost of a wider table.
+type Interpolation int
+
+const (
+	ThreePoint Interpolation = 3
+	FivePoint  Interpolation = 5
+)
+
+// Interpolates ys, centred on the middle value, at n from it.
+func (in Interpolation) interpolate(ys []float64, n float64) float64 {
+	if in == FivePoint {
+		return interpolate5(ys[0], ys[1], ys[2], ys[3], ys[4], n)
+	}
+	return interpolate3(ys[0], ys[1], ys[2], n)
+}
+
+func (in Interpolation) points() int {
+	if in == FivePoint {
+		return 5
+	}
+	return 3
+}
+
+// A function tabulated at X0, X0+Step, X0+2·Step, ...
+var ErrTableRange = errors.New("Table: x outside table")
+
+type Table struct {
+	X0, Step float64
+	Y        []float64
+}
+
+// Returns the index of the centre of the window of values used around x.
+func (t Table) centre(x float64, in Interpolation) (int, error) {
+	half := in.points() / 2
+	if len(t.Y) < in.points() {
+		return 0, fmt.Errorf("Table: %d values, need %d", len(t.Y), in.points())
+	}
+	i := int(math.Round((x - t.X0) / t.Step))
+	if i < half {
+		i = half
+	}
+	if i > len(t.Y)-1-half {
+		i = len(t.Y) - 1 - half
+	}
+	return i, nil
+}
+
+func (t Table) window(i int, in Interpolation) []float64 {
+	half := in.points() / 2
+	return t.Y[i-half : i+half+1]
+}
+
+// Returns the value at x, which must be within the table.
+func (t Table) At(x float64, in Interpolation) (float64, error) {
+	last := t.X0 + t.Step*float64(len(t.Y)-1)
+	if (x-t.X0)*(x-last) > 0 {
+		return 0, ErrTableRange
+	}
+	i, err := t.centre(x, in)
+	if err != nil {
+		return 0, err
+	}
+	n := (x - t.X0 - t.Step*float64(i)) / t.Step
+	return in.interpolate(t.window(i, in), n), nil
+}
+
+// Returns the first extremum in the table.
+func (t Table) Extremum(in Interpolation) (x, y float64, err error) {
+	return t.search(in, ErrNoExtremum, func(w []float64) (n, y float64, err error) {
+		if in == FivePoint {
+			return Extremum5(w[0], w[1], w[2], w[3], w[4])
+		}
+		return Extremum3(w[0], w[1], w[2])
+	})
+}
+
+// Returns the first zero in the table.
+func (t Table) Zero(in Interpolation) (float64, error) {
+	x, _, err := t.search(in, ErrNoZero, func(w []float64) (float64, float64, error) {
+		if in == FivePoint {
+			n, err := Zero5(w[0], w[1], w[2], w[3], w[4])
+			return n, 0, err
+		}
+		n, err := Zero3(w[0], w[1], w[2])
+		return n, 0, err
+	})
+	return x, err
+}
+
+// Slides the window along the table, accepting a result only if it's
+// nearer the centre of the window than any other.
+func (t Table) search(in Interpolation, notFound error, f func(w []float64) (n, y float64, err error)) (x, y float64, err error) {
+	half := in.points() / 2
+	for i := half; i < len(t.Y)-half; i++ {
+		n, y, err := f(t.window(i, in))
+		if err != nil {
+			continue
+		}
+		lo, hi := -0.5, 0.5
+		if i == half {
+			lo = -float64(half)
+		}
+		if i == len(t.Y)-1-half {
+			hi = float64(half)
+		}
+		if n >= lo && n < hi {
+			return t.X0 + t.Step*(float64(i)+n), y, nil
+		}
+	}
+	return 0, 0, notFound
 }
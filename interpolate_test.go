@@ -14,9 +14,138 @@ func TestInterpolate3(t *testing.T) {
 	}
 
 	for _, c := range cases {
-		got := Interpolate3(c.y1, c.y2, c.y3, c.n)
-		if math.Abs(c.want-got) > 0.000001 {
+		got, err := Interpolate3(c.y1, c.y2, c.y3, c.n)
+		if err != nil {
+			t.Errorf("Interpolate3(%f, %f, %f, %f): %v", c.y1, c.y2, c.y3, c.n, err)
+		} else if math.Abs(c.want-got) > 0.000001 {
 			t.Errorf("Interpolate3(%f, %f, %f, %f) == %f, want %f", c.y1, c.y2, c.y3, c.n, got, c.want)
 		}
 	}
 }
+
+func TestInterpolate3Range(t *testing.T) {
+	if _, err := Interpolate3(0, 0, 0, 1.5); err != ErrInterpolationRange {
+		t.Errorf("Interpolate3(n = 1.5) error == %v, want %v", err, ErrInterpolationRange)
+	}
+}
+
+func TestInterpolateAngle3(t *testing.T) {
+	cases := []struct {
+		a1, a2, a3 Angle
+		n          float64
+		want       Angle
+	}{
+		{Degrees(358), Degrees(0), Degrees(2), 0.5, Degrees(1)},
+		{Degrees(359), Degrees(1), Degrees(3), -0.5, Degrees(0)},
+		{Degrees(10), Degrees(20), Degrees(30), 1, Degrees(30)},
+	}
+	for _, c := range cases {
+		got, err := InterpolateAngle3(c.a1, c.a2, c.a3, c.n)
+		if err != nil {
+			t.Errorf("InterpolateAngle3(%v, %v, %v, %f): %v", c.a1, c.a2, c.a3, c.n, err)
+		} else if math.Abs((got - c.want).Normalize180().Degrees()) > 1e-9 {
+			t.Errorf("InterpolateAngle3(%v, %v, %v, %f) == %v, want %v", c.a1, c.a2, c.a3, c.n, got, c.want)
+		}
+	}
+	if _, err := InterpolateAngle3(0, 0, 0, 1.5); err != ErrInterpolationRange {
+		t.Errorf("InterpolateAngle3(n = 1.5) error == %v, want %v", err, ErrInterpolationRange)
+	}
+}
+
+func TestInterpolate5(t *testing.T) {
+	// Exact for a quartic
+	f := func(n float64) float64 { return poly(n, 1, -2, 0.5, 0.25, -0.125) }
+	got, err := Interpolate5(f(-2), f(-1), f(0), f(1), f(2), 0.37)
+	if err != nil || math.Abs(got-f(0.37)) > 1e-12 {
+		t.Errorf("Interpolate5() == %f, %v, want %f", got, err, f(0.37))
+	}
+	if _, err := Interpolate5(0, 0, 0, 0, 0, 2.5); err != ErrInterpolationRange {
+		t.Errorf("Interpolate5(n = 2.5) error == %v, want %v", err, ErrInterpolationRange)
+	}
+}
+
+func TestExtremum3(t *testing.T) {
+	// Ch 3 p.25: distance of Mars from the Sun, 1992 Nov 25-27
+	n, y, err := Extremum3(1.3814294, 1.3812213, 1.3812453)
+	if err != nil || math.Abs(n-0.39660) > 0.00001 || math.Abs(y-1.3812030) > 0.0000001 {
+		t.Errorf("Extremum3() == %f, %.7f, %v, want 0.39660, 1.3812030", n, y, err)
+	}
+	if _, _, err := Extremum3(1, 2, 3); err != ErrNoExtremum {
+		t.Errorf("Extremum3(1, 2, 3) error == %v, want %v", err, ErrNoExtremum)
+	}
+}
+
+func TestExtremum5(t *testing.T) {
+	f := func(n float64) float64 { return 3 - (n-0.4)*(n-0.4) + 0.01*math.Pow(n, 3) }
+	n, y, err := Extremum5(f(-2), f(-1), f(0), f(1), f(2))
+	// f'(n) = -2(n-0.4) + 0.03n² = 0
+	want := (2 - math.Sqrt(4-4*0.03*0.8)) / 0.06
+	if err != nil || math.Abs(n-want) > 1e-9 || math.Abs(y-f(want)) > 1e-9 {
+		t.Errorf("Extremum5() == %f, %f, %v, want %f, %f", n, y, err, want, f(want))
+	}
+}
+
+func TestZero3(t *testing.T) {
+	// Ch 3 p.26: declination of Mercury, 1973 Feb 26-28
+	y1 := -ms(28, 13.4)
+	y2 := ms(6, 46.3)
+	y3 := ms(38, 23.2)
+	n, err := Zero3(y1, y2, y3)
+	if err != nil || math.Abs(n-(-0.20127)) > 0.00001 {
+		t.Errorf("Zero3() == %f, %v, want -0.20127", n, err)
+	}
+	if _, err := Zero3(1, 2, 3); err != ErrNoZero {
+		t.Errorf("Zero3(1, 2, 3) error == %v, want %v", err, ErrNoZero)
+	}
+}
+
+func TestZero5(t *testing.T) {
+	f := func(n float64) float64 { return (n - 0.3) * (n + 4) * (n - 5) }
+	n, err := Zero5(f(-2), f(-1), f(0), f(1), f(2))
+	if err != nil || math.Abs(n-0.3) > 1e-9 {
+		t.Errorf("Zero5() == %f, %v, want 0.3", n, err)
+	}
+}
+
+func TestLagrange(t *testing.T) {
+	// Ch 3 p.33
+	x := []float64{29.43, 30.97, 27.69, 28.11, 31.58, 33.05}
+	y := []float64{0.4913598, 0.5145891, 0.4646875, 0.4711658, 0.5236885, 0.5453707}
+	got, err := Lagrange(x, y, 30)
+	if err != nil || math.Abs(got-0.5) > 1e-7 {
+		t.Errorf("Lagrange() == %.7f, %v, want 0.5", got, err)
+	}
+	if _, err := Lagrange(x, y[1:], 30); err == nil {
+		t.Errorf("Lagrange() with mismatched lengths succeeded")
+	}
+}
+
+func TestTable(t *testing.T) {
+	var table Table
+	table.X0, table.Step = 60, 10
+	for x := 60.0; x <= 200; x += 10 {
+		table.Y = append(table.Y, math.Sin(x*math.Pi/180))
+	}
+	for _, in := range []Interpolation{ThreePoint, FivePoint} {
+		tol := 1e-3
+		if in == FivePoint {
+			tol = 1e-5
+		}
+		if y, err := table.At(133, in); err != nil || math.Abs(y-math.Sin(133*math.Pi/180)) > tol {
+			t.Errorf("At(133, %d) == %f, %v", in, y, err)
+		}
+		if x, y, err := table.Extremum(in); err != nil || math.Abs(x-90) > 100*tol || math.Abs(y-1) > tol {
+			t.Errorf("Extremum(%d) == %f, %f, %v, want 90, 1", in, x, y, err)
+		}
+		if x, err := table.Zero(in); err != nil || math.Abs(x-180) > 100*tol {
+			t.Errorf("Zero(%d) == %f, %v, want 180", in, x, err)
+		}
+	}
+	if _, err := table.At(210, ThreePoint); err != ErrTableRange {
+		t.Errorf("At(210) error == %v, want %v", err, ErrTableRange)
+	}
+	short := Table{0, 1, []float64{1, 2, 3}}
+	if _, err := short.At(1, FivePoint); err == nil || err == ErrTableRange {
+		t.Errorf("At(1, FivePoint) on 3 values error == %v", err)
+	}
+}
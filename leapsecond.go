@@ -0,0 +1,146 @@
+package goastro
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// TAI-UTC (seconds) from Date onwards.
+type LeapSecond struct {
+	Date   Date
+	Offset int
+}
+
+var leapSecondsMu sync.RWMutex
+
+// From IERS Bulletin C
+var leapSeconds = []LeapSecond{
+	{Date{1972, 1, 1}, 10},
+	{Date{1972, 7, 1}, 11},
+	{Date{1973, 1, 1}, 12},
+	{Date{1974, 1, 1}, 13},
+	{Date{1975, 1, 1}, 14},
+	{Date{1976, 1, 1}, 15},
+	{Date{1977, 1, 1}, 16},
+	{Date{1978, 1, 1}, 17},
+	{Date{1979, 1, 1}, 18},
+	{Date{1980, 1, 1}, 19},
+	{Date{1981, 7, 1}, 20},
+	{Date{1982, 7, 1}, 21},
+	{Date{1983, 7, 1}, 22},
+	{Date{1985, 7, 1}, 23},
+	{Date{1988, 1, 1}, 24},
+	{Date{1990, 1, 1}, 25},
+	{Date{1991, 1, 1}, 26},
+	{Date{1992, 7, 1}, 27},
+	{Date{1993, 7, 1}, 28},
+	{Date{1994, 7, 1}, 29},
+	{Date{1996, 1, 1}, 30},
+	{Date{1997, 7, 1}, 31},
+	{Date{1999, 1, 1}, 32},
+	{Date{2006, 1, 1}, 33},
+	{Date{2009, 1, 1}, 34},
+	{Date{2012, 7, 1}, 35},
+	{Date{2015, 7, 1}, 36},
+	{Date{2017, 1, 1}, 37},
+}
+
+// Returns a copy of the leap second table in use.
+func LeapSeconds() []LeapSecond {
+	return append([]LeapSecond(nil), currentLeapSeconds()...)
+}
+
+// Returns the table in use. SetLeapSeconds replaces the slice rather than
+// modifying it, so the result can be read without holding the lock.
+func currentLeapSeconds() []LeapSecond {
+	leapSecondsMu.RLock()
+	defer leapSecondsMu.RUnlock()
+	return leapSeconds
+}
+
+// Replaces the leap second table. Entries must be in date order.
+func SetLeapSeconds(ls []LeapSecond) error {
+	if len(ls) == 0 {
+		return fmt.Errorf("SetLeapSeconds: empty table")
+	}
+	for i := 1; i < len(ls); i++ {
+		if !ls[i].Date.After(ls[i-1].Date) {
+			return fmt.Errorf("SetLeapSeconds: %v out of order", ls[i].Date)
+		}
+	}
+	ls = append([]LeapSecond(nil), ls...)
+	leapSecondsMu.Lock()
+	defer leapSecondsMu.Unlock()
+	leapSeconds = ls
+	return nil
+}
+
+// Parses an IERS Leap_Second.dat file.
+func ParseLeapSeconds(r io.Reader) ([]LeapSecond, error) {
+	var ls []LeapSecond
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimSpace(s.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		// MJD, day, month, year, TAI-UTC
+		f := strings.Fields(text)
+		if len(f) < 5 {
+			return nil, fmt.Errorf("leap seconds line %d: too few fields", line)
+		}
+		var n [4]int
+		for i := range n {
+			v, err := strconv.Atoi(f[i+1])
+			if err != nil {
+				return nil, fmt.Errorf("leap seconds line %d: %v", line, err)
+			}
+			n[i] = v
+		}
+		ls = append(ls, LeapSecond{Date{n[2], n[1], n[0]}, n[3]})
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return ls, nil
+}
+
+// Reads an IERS Leap_Second.dat file from disk and installs it.
+func LoadLeapSeconds(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	ls, err := ParseLeapSeconds(f)
+	if err != nil {
+		return err
+	}
+	return SetLeapSeconds(ls)
+}
+
+// Returns the index of the last entry of ls in effect on d, or -1 if d is
+// before the table.
+func leapSecondIndex(ls []LeapSecond, d Date) int {
+	i := len(ls) - 1
+	for i >= 0 && ls[i].Date.After(d) {
+		i--
+	}
+	return i
+}
+
+// Returns TAI-UTC in seconds at the start of d, and false if d is before
+// the leap second table.
+func TAIMinusUTC(d Date) (int, bool) {
+	ls := currentLeapSeconds()
+	i := leapSecondIndex(ls, d)
+	if i < 0 {
+		return 0, false
+	}
+	return ls[i].Offset, true
+}
@@ -0,0 +1,62 @@
+package goastro
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const leapSecondFile = `#  Value of TAI-UTC in second valid beetween the initial value until
+#  the epoch given on the next line.
+#
+#    MJD        Date        TAI-UTC (s)
+#           day month year
+#    ---    --------------   ------
+#
+    41317.0    1  1 1972       10
+    41499.0    1  7 1972       11
+    57754.0    1  1 2017       37
+`
+
+func TestParseLeapSeconds(t *testing.T) {
+	got, err := ParseLeapSeconds(strings.NewReader(leapSecondFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []LeapSecond{
+		{Date{1972, 1, 1}, 10},
+		{Date{1972, 7, 1}, 11},
+		{Date{2017, 1, 1}, 37},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ParseLeapSeconds() == %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("ParseLeapSeconds()[%d] == %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if _, err := ParseLeapSeconds(strings.NewReader("41317.0 1 1 x 10\n")); err == nil {
+		t.Error("ParseLeapSeconds(bad year) returned no error")
+	}
+}
+
+func TestLoadLeapSeconds(t *testing.T) {
+	defer SetLeapSeconds(LeapSeconds())
+	path := filepath.Join(t.TempDir(), "Leap_Second.dat")
+	if err := os.WriteFile(path, []byte(leapSecondFile), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadLeapSeconds(path); err != nil {
+		t.Fatal(err)
+	}
+	// The loaded table skips every leap second from 1973 to 2016.
+	if got, _ := TAIMinusUTC(Date{2000, 1, 1}); got != 11 {
+		t.Errorf("TAIMinusUTC(2000-01-01) == %d, want 11", got)
+	}
+	if _, ok := TAIMinusUTC(Date{1971, 12, 31}); ok {
+		t.Error("TAIMinusUTC(1971-12-31) reported a value")
+	}
+}
@@ -0,0 +1,31 @@
+package goastro
+
+// Ch 14 p.98
+// The angle between the directions to the zenith and to the north
+// celestial pole at p, positive west of the meridian. θ0 is the sidereal
+// time at Greenwich.
+func (p EquatorialPos) ParallacticAngle(θ0 Angle, ep EarthPos) Angle {
+	φ := ep.Lat
+	δ := p.Decl
+	H := θ0 + ep.Long - p.RA
+	// Multiplied through by cos φ, so this works at the poles.
+	return atan2(cos(φ)*sin(H), sin(φ)*cos(δ)-cos(φ)*sin(δ)*cos(H))
+}
+
+// Ch 14 p.99
+// The angle between the ecliptic and the horizon, for obliquity ε and
+// Greenwich sidereal time θ0.
+func EclipticHorizonAngle(ε, θ0 Angle, ep EarthPos) Angle {
+	φ := ep.Lat
+	θ := θ0 + ep.Long
+	return acos(cos(ε)*sin(φ) - sin(ε)*cos(φ)*sin(θ))
+}
+
+// The rate at which the field of view of an alt-az telescope rotates on
+// the sky while tracking p, i.e. the rate of change of the parallactic
+// angle, per hour of UT. It is unbounded at the zenith.
+func (p EquatorialPos) FieldRotationRate(θ0 Angle, ep EarthPos) Angle {
+	hp := p.HorizontalPos(θ0, ep)
+	ω := Degrees(siderealRate / 24)
+	return ω * Angle(cos(ep.Lat)*cos(hp.Azi)/cos(hp.Alt))
+}
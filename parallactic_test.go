@@ -0,0 +1,53 @@
+package goastro
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParallacticAngle(t *testing.T) {
+	ep := EarthPos{Degrees(38 + ms(55, 17)), -Degrees(77 + ms(3, 56))}
+	p := EquatorialPos{Hours(23 + ms(9, 16.641)), -Degrees(6 + ms(43, 11.61))}
+	transit := p.RA - ep.Long
+	if q := p.ParallacticAngle(transit, ep); math.Abs(q.Degrees()) > 1e-9 {
+		t.Errorf("ParallacticAngle() at transit == %v, want 0", q)
+	}
+	east := p.ParallacticAngle(transit-Degrees(30), ep)
+	west := p.ParallacticAngle(transit+Degrees(30), ep)
+	if !(west > 0) || math.Abs((east+west).Degrees()) > 1e-9 {
+		t.Errorf("ParallacticAngle() == %v east, %v west of the meridian", east, west)
+	}
+	// At the equator, a star on the equator rises straight up.
+	if q := (EquatorialPos{0, 0}).ParallacticAngle(Degrees(-60), EarthPos{}); math.Abs(q.Degrees()+90) > 1e-9 {
+		t.Errorf("ParallacticAngle() on the equator == %v, want -90°", q)
+	}
+}
+
+func TestEclipticHorizonAngle(t *testing.T) {
+	ε := Degrees(23.44)
+	ep := EarthPos{Degrees(51), 0}
+	for _, θ0 := range []Angle{0, Hours(5), Hours(13), Hours(20)} {
+		got := EclipticHorizonAngle(ε, θ0, ep)
+		// The angle between the planes is the angle between their poles.
+		zenith := EquatorialPos{θ0 + ep.Long, ep.Lat}
+		eclipticPole := EquatorialPos{Hours(18), 90 - ε}
+		if want := Separation(zenith, eclipticPole); math.Abs((got - want).Degrees()) > 1e-9 {
+			t.Errorf("EclipticHorizonAngle(%v) == %v, want %v", θ0, got, want)
+		}
+	}
+}
+
+func TestFieldRotationRate(t *testing.T) {
+	ep := EarthPos{Degrees(32), Degrees(-110)}
+	p := EquatorialPos{Degrees(80), Degrees(20)}
+	for _, H := range []Angle{-Degrees(40), 0, Degrees(15), Degrees(70)} {
+		θ0 := p.RA - ep.Long + H
+		got := p.FieldRotationRate(θ0, ep)
+		// Numerical derivative over a minute of time
+		dθ := Degrees(siderealRate / 24 / 60)
+		dq := (p.ParallacticAngle(θ0+dθ/2, ep) - p.ParallacticAngle(θ0-dθ/2, ep)).Normalize180()
+		if want := dq * 60; math.Abs((got - want).Degrees()) > 1e-4*math.Abs(want.Degrees()) {
+			t.Errorf("FieldRotationRate() at H = %v == %v/h, want %v/h", H, got, want)
+		}
+	}
+}
@@ -18,27 +18,88 @@ type HorizontalPos struct {
 	Azi, Alt Angle
 }
 
+// Dist is in AU, or 0 if unknown.
+type EclipticPos struct {
+	Long, Lat Angle
+	Dist      float64
+}
+
 type Positioner interface {
 	Position(t TD) EquatorialPos
 }
 
+// Makes a function such as SunPosition or SunPositionVSOP87 a Positioner.
+type PositionerFunc func(t TD) EquatorialPos
+
+func (f PositionerFunc) Position(t TD) EquatorialPos {
+	return f(t)
+}
+
 type DailyPositioner interface {
-	// Returns position for 0h TD
+	// Returns the position at the tabulation time on d: 0h TD unless
+	// given to MakeInterpolatedPositioner.
 	Position(d Date) EquatorialPos
 }
 
 // Wraps a DailyPositioner to make a fully-featured Positioner
 type InterpolatedPositioner struct {
-	dp DailyPositioner
+	dp     DailyPositioner
+	hours  float64 // TD tabulation time
+	interp Interpolation
+}
+
+// hours is the time of day (TD) at which dp gives positions.
+func MakeInterpolatedPositioner(dp DailyPositioner, hours float64) InterpolatedPositioner {
+	return InterpolatedPositioner{dp, hours, ThreePoint}
+}
+
+// Returns a copy of ip that interpolates between in.points() days of
+// positions. FivePoint is more accurate for fast-moving objects, such as
+// the Moon, but needs positions for two days either side.
+func (ip InterpolatedPositioner) WithInterpolation(in Interpolation) InterpolatedPositioner {
+	ip.interp = in
+	return ip
 }
 
+// Above this declination, RA changes too quickly to interpolate, so the
+// positions are interpolated as vectors.
+const polarDecl = 80
+
 func (ip InterpolatedPositioner) Position(t TD) EquatorialPos {
-	p1 := ip.dp.Position(t.date.AddDays(-1))
-	p2 := ip.dp.Position(t.date)
-	p3 := ip.dp.Position(t.date.AddDays(1))
-	ra := Interpolate3(float64(p1.RA), float64(p2.RA), float64(p3.RA), t.hours/24)
-	decl := Interpolate3(float64(p1.Decl), float64(p2.Decl), float64(p3.Decl), t.hours/24)
-	return EquatorialPos{Angle(ra), Angle(decl)}
+	// Centre on the last tabulated instant, so that 0 <= n < 1.
+	days := (t.hours - ip.hours) / 24
+	whole := math.Floor(days)
+	d := t.date.AddDays(int(whole))
+	n := days - whole
+	k := ip.interp.points()
+	ps := make([]EquatorialPos, k)
+	polar := false
+	for i := range ps {
+		ps[i] = ip.dp.Position(d.AddDays(i - k/2))
+		polar = polar || math.Abs(ps[i].Decl.Degrees()) > polarDecl
+	}
+	ys := make([]float64, k)
+	if polar {
+		var v Vec3
+		for j := range v {
+			for i, p := range ps {
+				ys[i] = p.Vec3()[j]
+			}
+			v[j] = ip.interp.interpolate(ys, n)
+		}
+		return v.EquatorialPos()
+	}
+	ras := make([]Angle, k)
+	for i, p := range ps {
+		ras[i] = p.RA
+	}
+	mid, unwrapped := unwrapAngles(ras)
+	ra := mid + Angle(ip.interp.interpolate(unwrapped, n))
+	for i, p := range ps {
+		ys[i] = float64(p.Decl)
+	}
+	decl := ip.interp.interpolate(ys, n)
+	return EquatorialPos{ra.Normalize(), Angle(decl)}
 }
 
 type rstType int
@@ -112,7 +173,7 @@ func risingSetting(p Positioner, h0 Angle, ep EarthPos, d Date, rst rstType) (UT
 			break
 		}
 	}
-	return UT{d, 24 * m}, nil
+	return UT{d, 24 * m}.Normalize(), nil
 }
 
 func Rising(p Positioner, h0 Angle, ep EarthPos, d Date) (UT, error) {
@@ -127,14 +188,19 @@ func Transit(p Positioner, ep EarthPos, d Date) (UT, error) {
 	return risingSetting(p, 0, ep, d, transitT)
 }
 
-func (p EquatorialPos) HorizontalPos(θ0 Angle, ep EarthPos) HorizontalPos {
+// Returns the geometric altitude unless WithRefraction is given.
+func (p EquatorialPos) HorizontalPos(θ0 Angle, ep EarthPos, opts ...HorizontalOption) HorizontalPos {
+	o := makeHorizontalOptions(opts)
 	α := p.RA
 	δ := p.Decl
 	L := -ep.Long
 	φ := ep.Lat
 	H := θ0 - L - α
-	A := atan2(sin(H), cos(H)*sin(φ)-tan(δ)*cos(φ))
+	A := atan2(cos(δ)*sin(H), cos(δ)*cos(H)*sin(φ)-sin(δ)*cos(φ)) // no tan(δ), so fine at the poles
 	h := asin(sin(φ)*sin(δ) + cos(φ)*cos(δ)*cos(H))
+	if o.atm != nil {
+		h += RefractionFromTrue(h, *o.atm)
+	}
 	return HorizontalPos{A, h}
 }
 
@@ -144,12 +210,17 @@ func (p EquatorialPos) TransitAltitude(lat Angle) Angle {
 	return asin(sin(φ)*sin(δ) + cos(φ)*cos(δ))
 }
 
-func (p HorizontalPos) EquatorialPos(θ0 Angle, ep EarthPos) EquatorialPos {
+// With WithRefraction, p.Alt is taken to be an apparent altitude.
+func (p HorizontalPos) EquatorialPos(θ0 Angle, ep EarthPos, opts ...HorizontalOption) EquatorialPos {
+	o := makeHorizontalOptions(opts)
 	A := p.Azi
 	h := p.Alt
+	if o.atm != nil {
+		h -= RefractionFromApparent(h, *o.atm)
+	}
 	L := -ep.Long
 	φ := ep.Lat
-	H := atan2(sin(A), cos(A)*sin(φ)+tan(h)*cos(φ))
+	H := atan2(cos(h)*sin(A), cos(h)*cos(A)*sin(φ)+sin(h)*cos(φ))
 	δ := asin(sin(φ)*sin(h) - cos(φ)*cos(h)*cos(A))
 	α := θ0 - L - H
 	return EquatorialPos{α, δ}
@@ -161,3 +232,24 @@ func (p EquatorialPos) HourAngle(h, lat Angle) Angle {
     cosH := (sin(h) - sin(φ) * sin(δ)) / (cos(φ) * cos(δ))
     return acos(cosH)
 }
+
+// Ch 13 p.93
+// ε is the obliquity of the ecliptic: TrueObliquity for apparent
+// positions, MeanObliquity for mean ones.
+func (p EclipticPos) EquatorialPos(ε Angle) EquatorialPos {
+	λ := p.Long
+	β := p.Lat
+	α := atan2(sin(λ)*cos(β)*cos(ε)-sin(β)*sin(ε), cos(λ)*cos(β))
+	δ := asin(sin(β)*cos(ε) + cos(β)*sin(ε)*sin(λ))
+	return EquatorialPos{α, δ}
+}
+
+// Ch 13 p.93
+// The result has no distance.
+func (p EquatorialPos) EclipticPos(ε Angle) EclipticPos {
+	α := p.RA
+	δ := p.Decl
+	λ := atan2(sin(α)*cos(δ)*cos(ε)+sin(δ)*sin(ε), cos(α)*cos(δ))
+	β := asin(sin(δ)*cos(ε) - cos(δ)*sin(ε)*sin(α))
+	return EclipticPos{λ.Normalize(), β, 0}
+}
@@ -1,7 +1,6 @@
 package goastro
 
 import (
-	"fmt"
 	"math"
 	"testing"
 	"time"
@@ -21,13 +20,70 @@ func (v Venus15a) Position(d Date) EquatorialPos {
 	panic("Venus15a.Position() date not supported")
 }
 
+// Moves along a great circle at a (nearly) steady rate, tabulated at 12h
+// TD.
+type greatCircleMover struct {
+	start, pole EquatorialPos // position at 2000-01-01 12h, and the circle's pole
+	rate        Angle         // per day
+	wobble      Angle         // amplitude of a variation in speed with a 4-week period
+}
+
+func (g greatCircleMover) at(days float64) EquatorialPos {
+	p, q := g.start.Vec3(), g.pole.Vec3()
+	r := q.Cross(p)
+	s := g.rate*Angle(days) + g.wobble*Angle(sin(Degrees(days*360/28)))
+	return p.Scale(cos(s)).Add(r.Scale(sin(s))).EquatorialPos()
+}
+
+func (g greatCircleMover) Position(d Date) EquatorialPos {
+	return g.at(float64(d.Sub(Date{2000, 1, 1})))
+}
+
+func TestInterpolatedPositioner(t *testing.T) {
+	cases := []struct {
+		name string
+		g    greatCircleMover
+	}{
+		{"across 0h", greatCircleMover{EquatorialPos{Degrees(359), Degrees(5)}, EquatorialPos{0, Degrees(90)}, Degrees(1.5), 0}},
+		{"over the pole", greatCircleMover{EquatorialPos{Degrees(10), Degrees(89)}, EquatorialPos{Degrees(100), 0}, -Degrees(1.5), 0}},
+	}
+	for _, c := range cases {
+		ip := MakeInterpolatedPositioner(c.g, 12)
+		for _, h := range []float64{0, 6, 12, 18, 30} {
+			td := TD{Date{2000, 1, 1}, h}
+			got := ip.Position(td)
+			want := c.g.at((h - 12) / 24)
+			if Separation(got, want).ArcSeconds() > 1 {
+				t.Errorf("%s: Position(%v) == %v, want %v", c.name, td, got, want)
+			}
+		}
+	}
+}
+
+func TestInterpolatedPositionerFivePoint(t *testing.T) {
+	// Like the Moon: fast, with a varying speed.
+	moon := greatCircleMover{EquatorialPos{Degrees(40), Degrees(10)}, EquatorialPos{Degrees(310), Degrees(70)}, Degrees(13.2), Degrees(6)}
+	three := MakeInterpolatedPositioner(moon, 12)
+	five := three.WithInterpolation(FivePoint)
+	var err3, err5 float64
+	for h := 0.0; h < 24; h += 3 {
+		td := TD{Date{2000, 1, 5}, h}
+		want := moon.at(4 + (h-12)/24)
+		err3 = math.Max(err3, Separation(three.Position(td), want).ArcSeconds())
+		err5 = math.Max(err5, Separation(five.Position(td), want).ArcSeconds())
+	}
+	if err5 > 10 || err5 >= err3 {
+		t.Errorf("largest error == %f\" with five points, %f\" with three", err5, err3)
+	}
+}
+
 func TestRising(t *testing.T) {
 	var venus Venus15a
 	h0 := Degrees(-0.5667)
 	ep := EarthPos{Degrees(42 + 20/60.), -Degrees(71 + 5/60.)}
 	d := Date{1988, 3, 20}
 	want := 12 + 25/60.
-	got, err := Rising(InterpolatedPositioner{venus}, h0, ep, d)
+	got, err := Rising(MakeInterpolatedPositioner(venus, 0), h0, ep, d)
 	if err != nil {
 		t.Error(err)
 	}
@@ -45,7 +101,7 @@ func TestSetting(t *testing.T) {
 	ep := EarthPos{Degrees(42 + 20/60.), -Degrees(71 + 5/60.)}
 	d := Date{1988, 3, 20}
 	want := 2 + 55/60.
-	got, err := Setting(InterpolatedPositioner{venus}, h0, ep, d)
+	got, err := Setting(MakeInterpolatedPositioner(venus, 0), h0, ep, d)
 	if err != nil {
 		t.Error(err)
 	}
@@ -62,7 +118,7 @@ func TestTransit(t *testing.T) {
 	ep := EarthPos{Degrees(42 + 20/60.), -Degrees(71 + 5/60.)}
 	d := Date{1988, 3, 20}
 	want := 19 + 41/60.
-	got, err := Transit(InterpolatedPositioner{venus}, ep, d)
+	got, err := Transit(MakeInterpolatedPositioner(venus, 0), ep, d)
 	if err != nil {
 		t.Error(err)
 	}
@@ -152,3 +208,19 @@ func TestEquatorialToHorizontalPos(t *testing.T) {
 		t.Errorf("got.Alt == %f, want %f", got.Alt, want.Alt)
 	}
 }
+
+func TestEclipticPos(t *testing.T) {
+	// Ch 13 p.95: Pollux
+	eq := EquatorialPos{Degrees(116.328942), Degrees(28.026183)}
+	ε := Degrees(23.4392911)
+	got := eq.EclipticPos(ε)
+	want := EclipticPos{Degrees(113.215630), Degrees(6.684170), 0}
+	if math.Abs((got.Long-want.Long).Degrees()) > 1e-6 || math.Abs((got.Lat-want.Lat).Degrees()) > 1e-6 {
+		t.Errorf("%v.EclipticPos() == %v, want %v", eq, got, want)
+	}
+
+	back := got.EquatorialPos(ε)
+	if math.Abs((back.RA-eq.RA).Normalize180().Degrees()) > 1e-9 || math.Abs((back.Decl-eq.Decl).Degrees()) > 1e-9 {
+		t.Errorf("%v.EquatorialPos() == %v, want %v", got, back, eq)
+	}
+}
@@ -0,0 +1,126 @@
+package goastro
+
+import (
+	"math"
+)
+
+// Standard epochs
+const (
+	J2000 JulianDay = 2451545.0
+	B1950 JulianDay = 2433282.4235
+)
+
+// Ch 21 p.134
+// Precession angles (IAU 1976) from the mean equinox of from to that of
+// to.
+func precessionAngles1976(from, to JulianDay) (ζ, z, θ Angle) {
+	T := float64(from-J2000) / 36525
+	t := float64(to-from) / 36525
+	c := 2306.2181 + T*(1.39656-T*0.000139)
+	ζ = ArcSeconds(t * (c + t*((0.30188-0.000344*T)+t*0.017998)))
+	z = ArcSeconds(t * (c + t*((1.09468+0.000066*T)+t*0.018203)))
+	θ = ArcSeconds(t * ((2004.3109 - T*(0.85330+T*0.000217)) - t*((0.42665+0.000217*T)+t*0.041833)))
+	return
+}
+
+// Ch 21 p.134
+func rotateEquatorial(p EquatorialPos, ζ, z, θ Angle) EquatorialPos {
+	α0 := p.RA
+	δ0 := p.Decl
+	A := cos(δ0) * sin(α0+ζ)
+	B := cos(θ)*cos(δ0)*cos(α0+ζ) - sin(θ)*sin(δ0)
+	C := sin(θ)*cos(δ0)*cos(α0+ζ) + cos(θ)*sin(δ0)
+	α := (atan2(A, B) + z).Normalize()
+	δ := atan2(C, math.Hypot(A, B)) // accurate near the poles too
+	return EquatorialPos{α, δ}
+}
+
+// Precesses p from the mean equinox of from to that of to.
+func precess(p EquatorialPos, from, to JulianDay) EquatorialPos {
+	if from == to {
+		return p
+	}
+	ζ, z, θ := precessionAngles1976(from, to)
+	return rotateEquatorial(p, ζ, z, θ)
+}
+
+type PrecessionModel int
+
+const (
+	IAU1976 PrecessionModel = iota // Lieske et al. (1977), as in the book
+	IAU2006                        // Capitaine et al. (2003), P03
+)
+
+// A mean position referred to the equator and equinox of Epoch.
+type EpochPos struct {
+	EquatorialPos
+	Epoch JulianDay
+}
+
+// Julian epoch, e.g. JulianEpoch(2000) == J2000.
+func JulianEpoch(year float64) JulianDay {
+	return J2000 + JulianDay((year-2000)*365.25)
+}
+
+// Besselian epoch, e.g. BesselianEpoch(1950) == B1950.
+func BesselianEpoch(year float64) JulianDay {
+	return B1950 + JulianDay((year-1950)*365.242198781)
+}
+
+// IAU 2006 precession angles from J2000 to the mean equinox of to.
+func precessionAngles2006(to JulianDay) (ζ, z, θ Angle) {
+	t := float64(to-J2000) / 36525
+	ζ = ArcSeconds(poly(t, 2.650545, 2306.083227, 0.2988499, 0.01801828, -0.000005971, -0.0000003173))
+	z = ArcSeconds(poly(t, -2.650545, 2306.077181, 1.0927348, 0.01826837, -0.000028596, -0.0000002904))
+	θ = ArcSeconds(poly(t, 0, 2004.191903, -0.4294934, -0.04182264, -0.000007089, -0.0000001274))
+	return
+}
+
+// Ch 21 p.134
+// Rigorous precession to the mean equator and equinox of epoch. Proper
+// motion is not applied.
+func (p EpochPos) Precess(epoch JulianDay, model PrecessionModel) EpochPos {
+	if model == IAU1976 {
+		return EpochPos{precess(p.EquatorialPos, p.Epoch, epoch), epoch}
+	}
+	// The IAU 2006 angles are referred to J2000, so go through it.
+	q := p.EquatorialPos
+	if p.Epoch != J2000 {
+		ζ, z, θ := precessionAngles2006(p.Epoch)
+		q = rotateEquatorial(q, -z, -ζ, -θ)
+	}
+	if epoch != J2000 {
+		ζ, z, θ := precessionAngles2006(epoch)
+		q = rotateEquatorial(q, ζ, z, θ)
+	}
+	return EpochPos{q, epoch}
+}
+
+// E-terms of aberration, and the FK4 to FK5 matrices for position and
+// for the fictitious proper motion (arcseconds per century)
+// Standish (1982), Aoki et al. (1983), as in SLALIB's FK45Z
+var (
+	fk4ETerms = Vec3{-1.62557e-6, -0.31919e-6, -0.13843e-6}
+	fk4ToFK5  = Mat3{
+		{+0.9999256782, -0.0111820611, -0.0048579477},
+		{+0.0111820610, +0.9999374784, -0.0000271765},
+		{+0.0048579479, -0.0000271474, +0.9999881997},
+	}
+	fk4ToFK5Motion = Mat3{
+		{-0.000551, -0.238565, +0.435739},
+		{+0.238514, -0.002667, -0.008541},
+		{-0.435623, +0.012254, +0.002117},
+	}
+)
+
+// Converts an FK4 position (equinox and epoch B1950) to FK5 J2000,
+// removing the E-terms of aberration. The object is assumed to have no
+// proper motion in FK5.
+func FK4ToFK5(p EquatorialPos) EquatorialPos {
+	r := p.Vec3()
+	v := r.Sub(fk4ETerms).Add(r.Scale(r.Dot(fk4ETerms)))
+	// Undo the fictitious FK5 proper motion between B1950 and J2000.
+	centuries := float64(B1950-J2000) / 36525
+	motion := fk4ToFK5Motion.Apply(v).Scale(centuries / (180 / math.Pi * 3600))
+	return fk4ToFK5.Apply(v).Add(motion).EquatorialPos()
+}
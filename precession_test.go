@@ -0,0 +1,61 @@
+package goastro
+
+import (
+	"testing"
+)
+
+func TestPrecess(t *testing.T) {
+	// Ch 21 p.135: θ Persei, with proper motion already applied
+	jd := JulianDay(2462088.69)
+	years := float64(jd-J2000) / 365.25
+	p := EpochPos{EquatorialPos{
+		Hours(2+ms(44, 11.986)) + Hours(0.03425*years/3600),
+		Degrees(49+ms(13, 42.48)) - ArcSeconds(0.0895*years),
+	}, J2000}
+	want := EquatorialPos{Degrees(41.547214), Degrees(49.348483)}
+
+	cases := []struct {
+		model PrecessionModel
+		tol   float64 // arcseconds
+	}{
+		{IAU1976, 0.01},
+		{IAU2006, 0.15}, // the models drift apart by ~0.3" a century
+	}
+	for _, c := range cases {
+		got := p.Precess(jd, c.model)
+		if got.Epoch != jd {
+			t.Errorf("Precess(%v).Epoch == %f, want %f", c.model, got.Epoch, jd)
+		}
+		if arcSecondDifference(got.RA, want.RA) > c.tol || arcSecondDifference(got.Decl, want.Decl) > c.tol {
+			t.Errorf("Precess(%v) == %v, want %v", c.model, got.EquatorialPos, want)
+		}
+
+		back := got.Precess(J2000, c.model)
+		if arcSecondDifference(back.RA, p.RA) > 1e-6 || arcSecondDifference(back.Decl, p.Decl) > 1e-6 {
+			t.Errorf("Precess(%v) round trip == %v, want %v", c.model, back.EquatorialPos, p.EquatorialPos)
+		}
+	}
+}
+
+func TestPrecessBetweenEpochs(t *testing.T) {
+	p := EpochPos{EquatorialPos{Degrees(100), Degrees(-30)}, BesselianEpoch(1900)}
+	to := JulianEpoch(2050)
+	direct := p.Precess(to, IAU2006)
+	indirect := p.Precess(J2000, IAU2006).Precess(to, IAU2006)
+	if arcSecondDifference(direct.RA, indirect.RA) > 1e-6 || arcSecondDifference(direct.Decl, indirect.Decl) > 1e-6 {
+		t.Errorf("Precess() == %v, via J2000 %v", direct.EquatorialPos, indirect.EquatorialPos)
+	}
+	old := p.Precess(to, IAU1976)
+	if arcSecondDifference(direct.RA, old.RA) > 0.5 || arcSecondDifference(direct.Decl, old.Decl) > 0.5 {
+		t.Errorf("Precess(IAU2006) == %v, Precess(IAU1976) == %v", direct.EquatorialPos, old.EquatorialPos)
+	}
+}
+
+func TestFK4ToFK5(t *testing.T) {
+	// The J2000 galactic pole was defined by converting the B1950 one.
+	got := FK4ToFK5(EquatorialPos{Degrees(192.25), Degrees(27.4)})
+	want := EquatorialPos{Degrees(192.85948), Degrees(27.12825)}
+	if arcSecondDifference(got.RA, want.RA) > 0.2 || arcSecondDifference(got.Decl, want.Decl) > 0.2 {
+		t.Errorf("FK4ToFK5() == %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,95 @@
+package goastro
+
+import (
+	"math"
+	"time"
+)
+
+// A Julian Day split into whole days and a fraction. A float64 JulianDay
+// near the present only resolves about 20µs; this keeps well under a
+// nanosecond.
+type PreciseJulianDay struct {
+	Day  int     // Julian Day at the preceding noon
+	Frac float64 // fraction of a day since then, in [0, 1)
+}
+
+const (
+	MJDEpoch  JulianDay = 2400000.5 // Modified Julian Day 0
+	UnixEpoch JulianDay = 2440587.5 // 1970-01-01 0h UTC
+)
+
+// J2000.0
+var j2000 = PreciseJulianDay{2451545, 0}
+
+func makePreciseJulianDay(day int, frac float64) PreciseJulianDay {
+	whole := math.Floor(frac)
+	return PreciseJulianDay{day + int(whole), frac - whole}
+}
+
+// Ch 7 p.60
+func MakePreciseJulianDay(t Time) PreciseJulianDay {
+	return makePreciseJulianDay(t.Date().jdn()-1, t.Hours()/24+0.5)
+}
+
+// Converts t without loss: the result is exact to well under a
+// nanosecond.
+func PreciseJulianDayFromTime(t time.Time) PreciseJulianDay {
+	sec := t.Unix()
+	days := floorDiv(int(sec), 86400)
+	secOfDay := float64(int(sec)-days*86400) + float64(t.Nanosecond())/1e9
+	return makePreciseJulianDay(int(UnixEpoch-0.5)+days, 0.5+secOfDay/86400)
+}
+
+// Rounds to the nearest nanosecond.
+func (j PreciseJulianDay) Time() time.Time {
+	days := j.Day - int(UnixEpoch-0.5)
+	ns := math.Round((j.Frac - 0.5) * 86400e9)
+	return time.Unix(int64(days)*86400, 0).Add(time.Duration(ns)).UTC()
+}
+
+func (j PreciseJulianDay) JulianDay() JulianDay {
+	return JulianDay(float64(j.Day) + j.Frac)
+}
+
+// Modified Julian Day: JD - 2400000.5
+func (j PreciseJulianDay) MJD() float64 {
+	return float64(j.Day-int(MJDEpoch-0.5)) + j.Frac - 0.5
+}
+
+func PreciseJulianDayFromMJD(mjd float64) PreciseJulianDay {
+	day, frac := math.Modf(mjd)
+	return makePreciseJulianDay(int(day)+int(MJDEpoch-0.5), frac+0.5)
+}
+
+// Seconds since 1970-01-01 0h UTC, ignoring leap seconds.
+func (j PreciseJulianDay) UnixSeconds() float64 {
+	return (float64(j.Day-int(UnixEpoch-0.5)) + j.Frac - 0.5) * 86400
+}
+
+func (j PreciseJulianDay) AddDays(n float64) PreciseJulianDay {
+	whole, frac := math.Modf(n)
+	return makePreciseJulianDay(j.Day+int(whole), j.Frac+frac)
+}
+
+// Returns j - k in days.
+func (j PreciseJulianDay) Sub(k PreciseJulianDay) float64 {
+	return float64(j.Day-k.Day) + (j.Frac - k.Frac)
+}
+
+// Returns the calendar date and hours of j.
+func (j PreciseJulianDay) calendarDate() (Date, float64) {
+	p := makePreciseJulianDay(j.Day, j.Frac+0.5)
+	return dateFromJDN(p.Day), p.Frac * 24
+}
+
+// Interprets j as a Julian Day in Universal Time.
+func (j PreciseJulianDay) UT() UT {
+	d, h := j.calendarDate()
+	return UT{d, h}
+}
+
+// Interprets j as a Julian Ephemeris Day (JDE).
+func (j PreciseJulianDay) TD() TD {
+	d, h := j.calendarDate()
+	return TD{d, h}
+}
@@ -0,0 +1,55 @@
+package goastro
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestPreciseJulianDayTime(t *testing.T) {
+	cases := []time.Time{
+		time.Date(2024, 3, 10, 12, 34, 56, 123456789, time.UTC),
+		time.Date(2000, 1, 1, 11, 59, 59, 999999999, time.UTC),
+		time.Date(1957, 10, 4, 19, 26, 24, 1, time.UTC),
+		time.Date(1600, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for _, c := range cases {
+		j := PreciseJulianDayFromTime(c)
+		if got := j.Time(); !got.Equal(c) {
+			t.Errorf("PreciseJulianDayFromTime(%v).Time() == %v", c, got)
+		}
+		want := MakeJulianDay(MakeUTC(c))
+		if math.Abs(float64(j.JulianDay()-want)) > 1e-9 {
+			t.Errorf("PreciseJulianDayFromTime(%v) == %f, want %f", c, j.JulianDay(), want)
+		}
+	}
+}
+
+func TestPreciseJulianDay(t *testing.T) {
+	j := MakePreciseJulianDay(UT{Date{2000, 1, 1}, 12})
+	if j != (PreciseJulianDay{2451545, 0}) {
+		t.Errorf("MakePreciseJulianDay(2000-01-01 12h) == %v", j)
+	}
+	if got := j.MJD(); got != 51544.5 {
+		t.Errorf("MJD() == %f, want 51544.5", got)
+	}
+	if got := PreciseJulianDayFromMJD(51544.5); got != j {
+		t.Errorf("PreciseJulianDayFromMJD(51544.5) == %v, want %v", got, j)
+	}
+	if got := j.UnixSeconds(); got != 946728000 {
+		t.Errorf("UnixSeconds() == %f, want 946728000", got)
+	}
+
+	// A microsecond is lost in a float64 JulianDay but not here.
+	k := j.AddDays(1e-6 / 86400)
+	if got := k.Sub(j) * 86400; math.Abs(got-1e-6) > 1e-12 {
+		t.Errorf("Sub() == %gs, want 1e-6s", got)
+	}
+	k = j.AddDays(-0.75)
+	if k != (PreciseJulianDay{2451544, 0.25}) {
+		t.Errorf("AddDays(-0.75) == %v", k)
+	}
+	if ut := k.UT(); ut.date != (Date{1999, 12, 31}) || ut.hours != 18 {
+		t.Errorf("UT() == %v, want 1999-12-31 18h", ut)
+	}
+}
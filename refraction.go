@@ -0,0 +1,90 @@
+package goastro
+
+// Local weather at the observer, for refraction.
+type Atmosphere struct {
+	Pressure    float64 // millibars
+	Temperature float64 // °C
+}
+
+// The conditions the refraction formulas were fitted to.
+func StandardAtmosphere() Atmosphere {
+	return Atmosphere{1010, 10}
+}
+
+// Ch 16 p.107
+func (a Atmosphere) scale() float64 {
+	return a.Pressure / 1010 * 283 / (273 + a.Temperature)
+}
+
+// The formulas only apply down to about 1° below the horizon (Ch 16
+// p.106). Below that, refraction is faded out linearly, reaching 0 at
+// refractionCutoff; objects that far down can't be seen anyway.
+const (
+	minRefractionAlt = -1
+	refractionCutoff = -5
+)
+
+// Evaluates the refraction formula f (arcminutes) at altitude h, applying
+// the cutoff and the weather.
+func refraction(h Angle, atm Atmosphere, f func(h float64) float64) Angle {
+	d := h.Degrees()
+	if d <= refractionCutoff {
+		return 0
+	}
+	fade := 1.0
+	if d < minRefractionAlt {
+		fade = (d - refractionCutoff) / (minRefractionAlt - refractionCutoff)
+		d = minRefractionAlt
+	}
+	return ArcMinutes(f(d) * fade * atm.scale())
+}
+
+// Ch 16 p.106 (Bennett)
+// Returns the refraction to subtract from an apparent altitude h0 to get
+// the true altitude.
+func RefractionFromApparent(h0 Angle, atm Atmosphere) Angle {
+	return refraction(h0, atm, func(h float64) float64 {
+		R := 1 / tan(Degrees(h+7.31/(h+4.4)))
+		return R - 0.06*sin(Degrees(14.7*R+13))
+	})
+}
+
+// Ch 16 p.106 (Saemundsson)
+// Returns the refraction to add to a true altitude h to get the apparent
+// altitude.
+func RefractionFromTrue(h Angle, atm Atmosphere) Angle {
+	return refraction(h, atm, func(h float64) float64 {
+		// 0.0019279 makes R vanish at the zenith.
+		return 1.02/tan(Degrees(h+10.3/(h+5.11))) + 0.0019279
+	})
+}
+
+// Ch 15 p.101
+// The altitude of an object's centre at rising and setting, for Rising and
+// Setting: refraction at the horizon plus the object's semidiameter (0 for
+// stars and planets, about 16' for the Sun).
+func StandardAltitude(atm Atmosphere, semidiameter Angle) Angle {
+	return -RefractionFromApparent(0, atm) - semidiameter
+}
+
+// An option for conversions between equatorial and horizontal positions.
+type HorizontalOption func(*horizontalOptions)
+
+type horizontalOptions struct {
+	atm *Atmosphere
+}
+
+func makeHorizontalOptions(opts []HorizontalOption) horizontalOptions {
+	var o horizontalOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// Makes horizontal altitudes apparent (refracted) rather than geometric.
+func WithRefraction(atm Atmosphere) HorizontalOption {
+	return func(o *horizontalOptions) {
+		o.atm = &atm
+	}
+}
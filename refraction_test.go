@@ -0,0 +1,98 @@
+package goastro
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRefractionFromApparent(t *testing.T) {
+	cases := []struct {
+		h0   Angle
+		want float64 // arcminutes
+	}{
+		{0, 34.5},
+		{Degrees(10), 5.3},
+		{Degrees(90), 0},
+	}
+	for _, c := range cases {
+		got := RefractionFromApparent(c.h0, StandardAtmosphere()).ArcMinutes()
+		if math.Abs(got-c.want) > 0.1 {
+			t.Errorf("RefractionFromApparent(%v) == %.3f', want %.1f'", c.h0, got, c.want)
+		}
+	}
+}
+
+// Ch 16 p.107: the two formulas agree to about 0.1'.
+func TestRefractionConsistency(t *testing.T) {
+	for _, d := range []float64{0, 0.5, 2, 5, 15, 45, 80} {
+		h0 := Degrees(d)
+		h := h0 - RefractionFromApparent(h0, StandardAtmosphere())
+		back := h + RefractionFromTrue(h, StandardAtmosphere())
+		if diff := (back - h0).ArcMinutes(); math.Abs(diff) > 0.15 {
+			t.Errorf("apparent %v -> true %v -> apparent %v", h0, h, back)
+		}
+	}
+}
+
+func TestRefractionWeather(t *testing.T) {
+	if R := RefractionFromTrue(Degrees(5), Atmosphere{0, 10}); R != 0 {
+		t.Errorf("refraction in vacuum == %v", R)
+	}
+	cold := RefractionFromTrue(Degrees(5), Atmosphere{1010, -20})
+	warm := RefractionFromTrue(Degrees(5), StandardAtmosphere())
+	if !(cold > warm) {
+		t.Errorf("refraction at -20°C (%v) not greater than at 10°C (%v)", cold, warm)
+	}
+}
+
+func TestStandardAltitude(t *testing.T) {
+	cases := []struct {
+		sd   Angle
+		want Angle
+	}{
+		{0, -Degrees(0.5667)},
+		{ArcMinutes(16), -Degrees(0.8333)},
+	}
+	for _, c := range cases {
+		got := StandardAltitude(StandardAtmosphere(), c.sd)
+		if math.Abs(got.ArcMinutes()-c.want.ArcMinutes()) > 1 {
+			t.Errorf("StandardAltitude(%v) == %v, want %v", c.sd, got, c.want)
+		}
+	}
+}
+
+func TestHorizontalPosRefraction(t *testing.T) {
+	ep := EarthPos{Degrees(38 + ms(55, 17)), -Degrees(77 + ms(3, 56))}
+	θ0 := Degrees(128.7378734)
+	p := EquatorialPos{Hours(23 + ms(9, 16.641)), -Degrees(6 + ms(43, 11.61))}
+	geometric := p.HorizontalPos(θ0, ep)
+	apparent := p.HorizontalPos(θ0, ep, WithRefraction(StandardAtmosphere()))
+	if apparent.Azi != geometric.Azi {
+		t.Errorf("refraction changed azimuth: %v, want %v", apparent.Azi, geometric.Azi)
+	}
+	R := apparent.Alt - geometric.Alt
+	if want := RefractionFromTrue(geometric.Alt, StandardAtmosphere()); arcSecondDifference(R, want) > 1e-6 {
+		t.Errorf("refraction == %v, want %v", R, want)
+	}
+	back := apparent.EquatorialPos(θ0, ep, WithRefraction(StandardAtmosphere()))
+	if Separation(back, p).ArcMinutes() > 0.15 {
+		t.Errorf("round trip == %v, want %v", back, p)
+	}
+}
+
+func TestRefractionBelowHorizon(t *testing.T) {
+	for _, d := range []float64{-5, -30, -90} {
+		if R := RefractionFromTrue(Degrees(d), StandardAtmosphere()); R != 0 {
+			t.Errorf("RefractionFromTrue(%v°) == %v, want 0", d, R)
+		}
+		if R := RefractionFromApparent(Degrees(d), StandardAtmosphere()); R != 0 {
+			t.Errorf("RefractionFromApparent(%v°) == %v, want 0", d, R)
+		}
+	}
+	// Fades out between -1° and the cutoff.
+	at1 := RefractionFromTrue(-1, StandardAtmosphere())
+	at3 := RefractionFromTrue(-3, StandardAtmosphere())
+	if math.Abs((at3 - at1/2).ArcSeconds()) > 1e-6 {
+		t.Errorf("RefractionFromTrue(-3°) == %v, want %v", at3, at1/2)
+	}
+}
@@ -0,0 +1,104 @@
+package goastro
+
+import (
+	"fmt"
+	"math"
+)
+
+// An equinox or solstice. The Sun's apparent longitude is 90° times the
+// Season.
+type Season int
+
+const (
+	MarchEquinox Season = iota
+	JuneSolstice
+	SeptemberEquinox
+	DecemberSolstice
+)
+
+func (s Season) String() string {
+	switch s {
+	case MarchEquinox:
+		return "March equinox"
+	case JuneSolstice:
+		return "June solstice"
+	case SeptemberEquinox:
+		return "September equinox"
+	case DecemberSolstice:
+		return "December solstice"
+	}
+	return fmt.Sprintf("Season(%d)", int(s))
+}
+
+// Ch 27 p.178
+// Mean equinoxes and solstices, Table 27.A for years -1000 to +1000 and
+// Table 27.B for +1000 to +3000.
+var meanSeasons = [2][4][]float64{
+	{
+		{1721139.29189, 365242.13740, 0.06134, 0.00111, -0.00071},
+		{1721233.25401, 365241.72562, -0.05323, 0.00907, 0.00025},
+		{1721325.70455, 365242.49558, -0.11677, -0.00297, 0.00074},
+		{1721414.39987, 365242.88257, -0.00769, -0.00933, -0.00006},
+	},
+	{
+		{2451623.80984, 365242.37404, 0.05169, -0.00411, -0.00057},
+		{2451716.56767, 365241.62603, 0.00325, 0.00888, -0.00030},
+		{2451810.21715, 365242.01767, -0.11575, 0.00337, 0.00078},
+		{2451900.05952, 365242.74049, -0.06223, -0.00823, 0.00032},
+	},
+}
+
+// Ch 27 p.177
+// Returns the instant of the equinox or solstice s in year, which must be
+// from -1000 to +3000.
+func Equinox(year int, s Season) (TD, error) {
+	if year < -1000 || year > 3000 {
+		return TD{}, fmt.Errorf("Equinox: year %d outside -1000 to +3000", year)
+	}
+	if s < MarchEquinox || s > DecemberSolstice {
+		return TD{}, fmt.Errorf("Equinox: invalid season %d", int(s))
+	}
+	table, Y := 0, float64(year)/1000
+	if year >= 1000 {
+		table, Y = 1, float64(year-2000)/1000
+	}
+	jde := poly(Y, meanSeasons[table][s]...)
+	// Correct the mean instant using the Sun's apparent longitude, rather
+	// than the periodic terms of Table 27.C.
+	target := Degrees(90 * float64(s))
+	for i := 0; i < 10; i++ {
+		λ := SunEclipticPositionVSOP87(JulianDay(jde).TD()).Long
+		Δ := 58 * sin(target-λ)
+		jde += Δ
+		if math.Abs(Δ) < 1e-7 {
+			break
+		}
+	}
+	return JulianDay(jde).TD(), nil
+}
+
+// Returns the four equinoxes and solstices of year, in order.
+func Seasons(year int) ([4]TD, error) {
+	var tds [4]TD
+	for s := range tds {
+		td, err := Equinox(year, Season(s))
+		if err != nil {
+			return tds, err
+		}
+		tds[s] = td
+	}
+	return tds, nil
+}
+
+// Like Seasons, but in UT.
+func SeasonsUT(year int) ([4]UT, error) {
+	var uts [4]UT
+	tds, err := Seasons(year)
+	if err != nil {
+		return uts, err
+	}
+	for i, td := range tds {
+		uts[i] = td.UT()
+	}
+	return uts, nil
+}
@@ -0,0 +1,60 @@
+package goastro
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEquinox(t *testing.T) {
+	// Ch 27 p.180: the periodic terms give 21h25m08s TD, but the correct
+	// instant is 21h24m42s TD.
+	got, err := Equinox(1962, JuneSolstice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := TD{Date{1962, 6, 21}, 21 + ms(24, 42)}
+	if Δ := got.Sub(want); Δ.Abs().Seconds() > 3 {
+		t.Errorf("Equinox(1962, JuneSolstice) == %v, want %v", got, want)
+	}
+}
+
+func TestSeasons(t *testing.T) {
+	for _, year := range []int{-1000, -500, 999, 1000, 2024, 3000} {
+		tds, err := Seasons(year)
+		if err != nil {
+			t.Errorf("Seasons(%d): %v", year, err)
+			continue
+		}
+		for s, td := range tds {
+			if td.Date().Year != year {
+				t.Errorf("Seasons(%d): %v is %v", year, Season(s), td)
+			}
+			λ := SunEclipticPositionVSOP87(td).Long
+			if d := (λ - Degrees(90*float64(s))).Normalize180(); math.Abs(d.ArcSeconds()) > 0.01 {
+				t.Errorf("Seasons(%d): Sun's longitude at %v == %v", year, Season(s), λ)
+			}
+		}
+	}
+}
+
+func TestSeasonsUT(t *testing.T) {
+	// 2024 March equinox: 03:06 UT on March 20
+	uts, err := SeasonsUT(2024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := uts[MarchEquinox]; got.Date() != (Date{2024, 3, 20}) || math.Abs(got.Hours()-(3+6/60.)) > 1/60. {
+		t.Errorf("SeasonsUT(2024)[MarchEquinox] == %v, want 2024-03-20 03:06", got)
+	}
+}
+
+func TestEquinoxRange(t *testing.T) {
+	for _, year := range []int{-1001, 3001} {
+		if _, err := Equinox(year, MarchEquinox); err == nil {
+			t.Errorf("Equinox(%d) succeeded", year)
+		}
+	}
+	if _, err := Equinox(2000, Season(4)); err == nil {
+		t.Errorf("Equinox(2000, Season(4)) succeeded")
+	}
+}
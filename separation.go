@@ -0,0 +1,79 @@
+package goastro
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// Ch 17 p.109
+// Uses the Vincenty formula rather than the book's, so it is accurate for
+// small separations and near the poles.
+func Separation(p1, p2 EquatorialPos) Angle {
+	δ1 := p1.Decl
+	δ2 := p2.Decl
+	Δα := p2.RA - p1.RA
+	x := cos(δ1)*sin(δ2) - sin(δ1)*cos(δ2)*cos(Δα)
+	y := cos(δ2) * sin(Δα)
+	z := sin(δ1)*sin(δ2) + cos(δ1)*cos(δ2)*cos(Δα)
+	return atan2(math.Hypot(x, y), z)
+}
+
+// Position angle of p2 seen from p1, measured from north through east.
+func PositionAngle(p1, p2 EquatorialPos) Angle {
+	δ1 := p1.Decl
+	δ2 := p2.Decl
+	Δα := p2.RA - p1.RA
+	return atan2(sin(Δα)*cos(δ2), cos(δ1)*sin(δ2)-sin(δ1)*cos(δ2)*cos(Δα)).Normalize()
+}
+
+// Finds the instant between start and end when p1 and p2 are closest,
+// and their separation then. The positions are sampled every step, which
+// must be short compared with the time between successive minima.
+func ClosestApproach(p1, p2 Positioner, start, end TD, step time.Duration) (TD, Angle, error) {
+	if step <= 0 {
+		return TD{}, 0, errors.New("ClosestApproach: step must be positive")
+	}
+	if end.Before(start) {
+		return TD{}, 0, errors.New("ClosestApproach: end before start")
+	}
+	sep := func(t TD) Angle {
+		return Separation(p1.Position(t), p2.Position(t))
+	}
+
+	best, bestSep := start, sep(start)
+	for t := start.Add(step); !t.After(end); t = t.Add(step) {
+		if s := sep(t); s < bestSep {
+			best, bestSep = t, s
+		}
+	}
+	if s := sep(end); s < bestSep {
+		best, bestSep = end, s
+	}
+
+	// Golden-section search around the best sample
+	lo := best.Add(-step)
+	if lo.Before(start) {
+		lo = start
+	}
+	hi := best.Add(step)
+	if hi.After(end) {
+		hi = end
+	}
+	const φ = 0.6180339887498949
+	for hi.Sub(lo) > time.Second {
+		span := hi.Sub(lo)
+		a := hi.Add(-time.Duration(φ * float64(span)))
+		b := lo.Add(time.Duration(φ * float64(span)))
+		if sep(a) < sep(b) {
+			hi = b
+		} else {
+			lo = a
+		}
+	}
+	mid := lo.Add(hi.Sub(lo) / 2)
+	if s := sep(mid); s < bestSep {
+		best, bestSep = mid, s
+	}
+	return best, bestSep, nil
+}
@@ -0,0 +1,80 @@
+package goastro
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSeparation(t *testing.T) {
+	cases := []struct {
+		p1, p2 EquatorialPos
+		want   Angle
+	}{
+		// Ch 17 p.110: Arcturus and Spica
+		{EquatorialPos{Degrees(213.9154), Degrees(19.1825)}, EquatorialPos{Degrees(201.2983), -Degrees(11.1614)}, Degrees(32.7930)},
+		{EquatorialPos{Degrees(10), Degrees(89.9999)}, EquatorialPos{Degrees(190), Degrees(89.9999)}, Degrees(0.0002)},
+		{EquatorialPos{Degrees(10), Degrees(20)}, EquatorialPos{Degrees(10), Degrees(20) + ArcSeconds(0.001)}, ArcSeconds(0.001)},
+		{EquatorialPos{0, 0}, EquatorialPos{Degrees(180), 0}, Degrees(180)},
+	}
+	for _, c := range cases {
+		got := Separation(c.p1, c.p2)
+		if arcSecondDifference(got, c.want) > 1e-6*math.Max(1, c.want.ArcSeconds()) {
+			t.Errorf("Separation(%v, %v) == %v, want %v", c.p1, c.p2, got, c.want)
+		}
+	}
+}
+
+func TestPositionAngle(t *testing.T) {
+	p := EquatorialPos{Degrees(30), Degrees(10)}
+	cases := []struct {
+		p2   EquatorialPos
+		want Angle
+	}{
+		{EquatorialPos{Degrees(30), Degrees(11)}, 0},
+		{EquatorialPos{Degrees(31), Degrees(10)}, Degrees(90)},
+		{EquatorialPos{Degrees(30), Degrees(9)}, Degrees(180)},
+		{EquatorialPos{Degrees(29), Degrees(10)}, Degrees(270)},
+	}
+	for _, c := range cases {
+		got := PositionAngle(p, c.p2)
+		if math.Abs((got - c.want).Normalize180().Degrees()) > 0.1 {
+			t.Errorf("PositionAngle(%v, %v) == %v, want %v", p, c.p2, got, c.want)
+		}
+	}
+}
+
+type fixedPositioner EquatorialPos
+
+func (p fixedPositioner) Position(t TD) EquatorialPos {
+	return EquatorialPos(p)
+}
+
+// Moves 1° per day in RA along declination 0.5°, reaching RA 10° at
+// 2000-01-05 6h TD.
+type movingPositioner struct{}
+
+func (movingPositioner) Position(t TD) EquatorialPos {
+	days := t.Sub(TD{Date{2000, 1, 5}, 6}).Hours() / 24
+	return EquatorialPos{Degrees(10 + days), Degrees(0.5)}
+}
+
+func TestClosestApproach(t *testing.T) {
+	start := TD{Date{2000, 1, 1}, 0}
+	end := TD{Date{2000, 1, 10}, 0}
+	got, sep, err := ClosestApproach(fixedPositioner{Degrees(10), 0}, movingPositioner{}, start, end, 12*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := TD{Date{2000, 1, 5}, 6}
+	if d := got.Sub(want); d > 2*time.Second || d < -2*time.Second {
+		t.Errorf("ClosestApproach() at %v, want %v", got, want)
+	}
+	if arcSecondDifference(sep, Degrees(0.5)) > 0.01 {
+		t.Errorf("ClosestApproach() separation %v, want 0.5°", sep)
+	}
+
+	if _, _, err := ClosestApproach(fixedPositioner{}, movingPositioner{}, end, start, time.Hour); err == nil {
+		t.Error("ClosestApproach(end before start) returned no error")
+	}
+}
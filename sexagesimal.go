@@ -0,0 +1,191 @@
+package goastro
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Decimals of seconds that HMS and DMS will show. More would overflow the
+// int64 they count in, and be meaningless anyway.
+const maxSexagesimalPrec = 9
+
+// Limits prec to 0..maxSexagesimalPrec.
+func clampPrec(prec int) int {
+	if prec < 0 {
+		return 0
+	}
+	if prec > maxSexagesimalPrec {
+		return maxSexagesimalPrec
+	}
+	return prec
+}
+
+// Splits |x| (degrees or hours) into whole units, minutes and seconds,
+// with the seconds rounded to prec decimals and carried into the minutes
+// and units when they round up to 60. neg is false if x rounds to zero.
+// prec must already be clamped.
+func sexagesimal(x float64, prec int) (neg bool, u, m int64, s float64) {
+	scale := math.Pow(10, float64(prec))
+	total := int64(math.Round(math.Abs(x) * 3600 * scale))
+	neg = x < 0 && total != 0
+	perMinute := int64(60 * scale)
+	u = total / (60 * perMinute)
+	total -= u * 60 * perMinute
+	m = total / perMinute
+	s = float64(total-m*perMinute) / scale
+	return
+}
+
+// Formats seconds with prec decimals and two integer digits.
+func formatSeconds(s float64, prec int) string {
+	width := 2
+	if prec > 0 {
+		width += prec + 1
+	}
+	return fmt.Sprintf("%0*.*f", width, prec, s)
+}
+
+// Formats a as hours, minutes and seconds with prec decimals, e.g.
+// "12h34m56.70s", as used for right ascension. a is normalized, so the
+// result is from 00h up to, but not including, 24h. prec is limited to
+// 0..9.
+func (a Angle) HMS(prec int) string {
+	prec = clampPrec(prec)
+	_, h, m, s := sexagesimal(a.Normalize().Hours(), prec)
+	if h == 24 {
+		h = 0
+	}
+	return fmt.Sprintf("%02dh%02dm%ss", h, m, formatSeconds(s, prec))
+}
+
+// Formats a as signed degrees, minutes and seconds with prec decimals,
+// e.g. "-05°23'12.0\"", as used for declination. prec is limited to
+// 0..9.
+func (a Angle) DMS(prec int) string {
+	prec = clampPrec(prec)
+	neg, d, m, s := sexagesimal(a.Degrees(), prec)
+	sign := "+"
+	if neg {
+		sign = "-"
+	}
+	return fmt.Sprintf("%s%02d°%02d'%s\"", sign, d, m, formatSeconds(s, prec))
+}
+
+// Parses an angle in degrees: "-05°23'12\"", "-5d23m12s", "+12:34:56",
+// "-5 23 12.5" or "12.5". Hours are accepted with an explicit unit:
+// "12h34m56.7s".
+func ParseAngle(s string) (Angle, error) {
+	return parseSexagesimal(s, false)
+}
+
+// Like ParseAngle, but numbers without units are hours: "12:34:56.7" or
+// "12.5".
+func ParseHours(s string) (Angle, error) {
+	return parseSexagesimal(s, true)
+}
+
+// Parses a time of day such as "12:34:56.7", "12h34m" or "6.5".
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	a, err := parseSexagesimal(s, true)
+	if err != nil {
+		return 0, err
+	}
+	h := a.Hours()
+	if h < 0 || h >= 24 {
+		return 0, fmt.Errorf("time of day %q out of range", s)
+	}
+	return TimeOfDay(h), nil
+}
+
+// Unit symbols for each sexagesimal field, for angles in degrees and in
+// hours
+var (
+	degreeUnits = []string{"°d", "m'′", "s\"″"}
+	hourUnits   = []string{"h", "m", "s"}
+)
+
+func parseSexagesimal(s string, hours bool) (Angle, error) {
+	bad := func(why string) (Angle, error) {
+		return 0, fmt.Errorf("angle %q: %s", s, why)
+	}
+	r := []rune(strings.TrimSpace(s))
+	neg := false
+	if len(r) > 0 && (r[0] == '-' || r[0] == '+') {
+		neg = r[0] == '-'
+		r = r[1:]
+	}
+	var fields []float64
+	// Either unit symbols or bare separators (':' or spaces), not both
+	var units, bare bool
+	unitSymbols := degreeUnits
+	for i := 0; i < len(r); {
+		if len(fields) == 3 {
+			return bad("too many fields")
+		}
+		start := i
+		for i < len(r) && (unicode.IsDigit(r[i]) || r[i] == '.') {
+			i++
+		}
+		if i == start {
+			return bad("expected a number")
+		}
+		v, err := strconv.ParseFloat(string(r[start:i]), 64)
+		if err != nil {
+			return bad("bad number")
+		}
+		n := len(fields)
+		if n > 0 && v >= 60 {
+			return bad("minutes or seconds not below 60")
+		}
+		if n > 0 && math.Trunc(fields[n-1]) != fields[n-1] {
+			return bad("fraction before the last field")
+		}
+		fields = append(fields, v)
+		if i < len(r) {
+			switch c := r[i]; {
+			case n == 0 && c == 'h':
+				unitSymbols = hourUnits
+				hours = true
+				units = true
+				i++
+			case strings.ContainsRune(unitSymbols[n], c):
+				if n == 0 {
+					hours = false
+				}
+				units = true
+				i++
+			case c == ':' || unicode.IsSpace(c):
+				bare = true
+				i++
+				if i == len(r) {
+					return bad("missing field after separator")
+				}
+			default:
+				return bad(fmt.Sprintf("unexpected %q", c))
+			}
+		}
+		if units && bare {
+			return bad("mixed unit symbols and separators")
+		}
+		for i < len(r) && unicode.IsSpace(r[i]) {
+			i++
+		}
+	}
+	if len(fields) == 0 {
+		return bad("empty")
+	}
+	v := 0.0
+	for i, f := range fields {
+		v += f / math.Pow(60, float64(i))
+	}
+	if neg {
+		v = -v
+	}
+	if hours {
+		return Hours(v), nil
+	}
+	return Degrees(v), nil
+}
@@ -0,0 +1,149 @@
+package goastro
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseAngle(t *testing.T) {
+	cases := []struct {
+		s    string
+		want Angle
+	}{
+		{"12h34m56.7s", Hours(12 + ms(34, 56.7))},
+		{"-05°23'12\"", -Degrees(5 + ms(23, 12))},
+		{"-0°30'", -Degrees(0.5)},
+		{"+12:34:56", Degrees(12 + ms(34, 56))},
+		{"-5d23m12.5s", -Degrees(5 + ms(23, 12.5))},
+		{"49 13 42.48", Degrees(49 + ms(13, 42.48))},
+		{"12.5", Degrees(12.5)},
+		{"-12.5°", -Degrees(12.5)},
+		{"2h", Hours(2)},
+		{" 41°33′20″ ", Degrees(41 + ms(33, 20))},
+	}
+	for _, c := range cases {
+		got, err := ParseAngle(c.s)
+		if err != nil {
+			t.Errorf("ParseAngle(%q): %v", c.s, err)
+		} else if math.Abs(got.ArcSeconds()-c.want.ArcSeconds()) > 1e-9 {
+			t.Errorf("ParseAngle(%q) == %v, want %v", c.s, got, c.want)
+		}
+	}
+}
+
+func TestParseAngleErrors(t *testing.T) {
+	for _, s := range []string{"", "-", "12:60", "12.5:30", "1:2:3:4", "12x", "12h34m56s7", "12h34'", "12h34m56\"", "12:34m", "12:34'56\"", "12°34:56", "12 34m", "12:", "12:34:"} {
+		if got, err := ParseAngle(s); err == nil {
+			t.Errorf("ParseAngle(%q) == %v, want error", s, got)
+		}
+	}
+}
+
+func TestParseHoursTrailingSeparator(t *testing.T) {
+	for _, s := range []string{"12:", "12:34:"} {
+		if got, err := ParseHours(s); err == nil {
+			t.Errorf("ParseHours(%q) == %v, want error", s, got)
+		}
+	}
+}
+
+func TestParseHours(t *testing.T) {
+	got, err := ParseHours("02:44:11.986")
+	if want := Hours(2 + ms(44, 11.986)); err != nil || math.Abs(got.ArcSeconds()-want.ArcSeconds()) > 1e-9 {
+		t.Errorf("ParseHours() == %v, %v, want %v", got, err, want)
+	}
+	// An explicit unit wins.
+	got, err = ParseHours("12.5°")
+	if err != nil || got != Degrees(12.5) {
+		t.Errorf("ParseHours(\"12.5°\") == %v, %v", got, err)
+	}
+}
+
+func TestParseTimeOfDay(t *testing.T) {
+	got, err := ParseTimeOfDay("19:21:00")
+	if err != nil || math.Abs(float64(got)-(19+21/60.)) > 1e-12 {
+		t.Errorf("ParseTimeOfDay() == %v, %v", got, err)
+	}
+	for _, s := range []string{"24:00:00", "-1:00"} {
+		if _, err := ParseTimeOfDay(s); err == nil {
+			t.Errorf("ParseTimeOfDay(%q) succeeded", s)
+		}
+	}
+}
+
+func TestHMS(t *testing.T) {
+	cases := []struct {
+		a    Angle
+		prec int
+		want string
+	}{
+		{Hours(12 + ms(34, 56.7)), 2, "12h34m56.70s"},
+		{Hours(2 + ms(59, 59.996)), 2, "03h00m00.00s"},
+		{Hours(2 + ms(59, 59.4)), 0, "02h59m59s"},
+		{-Hours(ms(0, 1.26)), 1, "23h59m58.7s"},
+		{Hours(23 + ms(59, 59.996)), 2, "00h00m00.00s"},
+		{Hours(24 + ms(0, 5)), 0, "00h00m05s"},
+	}
+	for _, c := range cases {
+		if got := c.a.HMS(c.prec); got != c.want {
+			t.Errorf("HMS(%d) == %q, want %q", c.prec, got, c.want)
+		}
+	}
+}
+
+func TestDMS(t *testing.T) {
+	cases := []struct {
+		a    Angle
+		prec int
+		want string
+	}{
+		{-Degrees(5 + ms(23, 12)), 1, "-05°23'12.0\""},
+		{Degrees(49 + ms(13, 42.48)), 1, "+49°13'42.5\""},
+		{-Degrees(0.5), 0, "-00°30'00\""},
+		{Degrees(29 + ms(59, 59.97)), 1, "+30°00'00.0\""},
+		{-ArcSeconds(0.01), 1, "+00°00'00.0\""},
+	}
+	for _, c := range cases {
+		if got := c.a.DMS(c.prec); got != c.want {
+			t.Errorf("DMS(%d) == %q, want %q", c.prec, got, c.want)
+		}
+	}
+}
+
+func TestAngleString(t *testing.T) {
+	cases := []struct {
+		a    Angle
+		want string
+	}{
+		{Degrees(-0.5), "-0°30'0\".0/-0.50000"},
+		{-ArcMinutes(1.5), "-0°1'30\".0/-0.02500"},
+		{-ArcSeconds(1e-5), "0°0'0\".0/-0.00000"},
+		{Degrees(12.5), "12°30'0\".0/12.50000"},
+	}
+	for _, c := range cases {
+		if got := c.a.String(); got != c.want {
+			t.Errorf("String() == %q, want %q", got, c.want)
+		}
+	}
+}
+
+func TestSexagesimalPrecision(t *testing.T) {
+	a := Hours(12 + ms(34, 56.789))
+	cases := []struct {
+		got, want string
+	}{
+		{a.HMS(-1), "12h34m57s"},
+		{a.HMS(0), "12h34m57s"},
+		{a.HMS(9), a.HMS(15)},
+		{a.DMS(-3), "+188°44'12\""},
+		{a.DMS(9), a.DMS(13)},
+	}
+	for _, c := range cases {
+		if c.got != c.want {
+			t.Errorf("got %q, want %q", c.got, c.want)
+		}
+	}
+	if got := a.HMS(13); len(got) != len("12h34m56.789000000s") {
+		t.Errorf("HMS(13) == %q, want 9 decimals", got)
+	}
+}
@@ -0,0 +1,92 @@
+package goastro
+
+// A star as given in a catalog: its mean position at Epoch, referred to
+// the equator and equinox of Epoch (J2000 if zero).
+type Star struct {
+	RA, Decl       Angle
+	PMRA, PMDecl   Angle   // proper motion per Julian year; PMRA is the change in RA, not RA·cos(Decl)
+	Parallax       Angle   // zero if unknown
+	RadialVelocity float64 // km/s, positive when receding
+	Epoch          JulianDay
+}
+
+func (s Star) epoch() JulianDay {
+	if s.Epoch == 0 {
+		return J2000
+	}
+	return s.Epoch
+}
+
+// AU/year in km/s
+const auPerYear = 4.740470446
+
+// Returns the star's mean position for the equinox of t, with space motion
+// and annual parallax applied.
+func (s Star) MeanPosition(t TD) EpochPos {
+	jde := MakeJulianDay(t)
+	years := float64(jde-s.epoch()) / 365.25
+	α := s.RA
+	δ := s.Decl
+
+	// Space motion, in units of the star's distance
+	r := EquatorialPos{α, δ}.Vec3()
+	eα := Vec3{-sin(α), cos(α), 0}
+	eδ := Vec3{-sin(δ) * cos(α), -sin(δ) * sin(α), cos(δ)}
+	μα := s.PMRA.Radians() * cos(δ)
+	μδ := s.PMDecl.Radians()
+	μr := s.RadialVelocity * s.Parallax.Radians() / auPerYear
+	p := r.Add(eα.Scale(years * μα)).Add(eδ.Scale(years * μδ)).Add(r.Scale(years * μr))
+
+	// Annual parallax: move the origin from the Sun to the Earth.
+	if s.Parallax > 0 {
+		sun := SunEclipticPosition(t)
+		sunEq := sun.EquatorialPos(MeanObliquity(t))
+		p = p.Add(sunEq.Vec3().Scale(sun.Dist * s.Parallax.Radians()))
+	}
+
+	mean := EpochPos{p.EquatorialPos(), s.epoch()}
+	return mean.Precess(jde, IAU1976)
+}
+
+// Ch 23 p.151
+// Apparent position: mean position of date corrected for nutation and
+// annual aberration.
+func (s Star) Position(t TD) EquatorialPos {
+	p := s.MeanPosition(t).EquatorialPos
+	Δα1, Δδ1 := NutationCorrection(p, t)
+	Δα2, Δδ2 := AberrationCorrection(p, t)
+	return EquatorialPos{(p.RA + Δα1 + Δα2).Normalize(), p.Decl + Δδ1 + Δδ2}
+}
+
+// Ch 23 p.151
+// Corrections to take a mean position of the date to the true equator and
+// equinox.
+func NutationCorrection(p EquatorialPos, t TD) (Δα, Δδ Angle) {
+	α := p.RA
+	δ := p.Decl
+	ε := TrueObliquity(t)
+	Δψ := LongitudeNutation(t)
+	Δε := ObliquityNutation(t)
+	Δα = Angle((cos(ε)+sin(ε)*sin(α)*tan(δ))*float64(Δψ) - cos(α)*tan(δ)*float64(Δε))
+	Δδ = Angle(sin(ε)*cos(α)*float64(Δψ) + sin(α)*float64(Δε))
+	return
+}
+
+// Ch 23 p.151
+// Corrections for annual aberration.
+func AberrationCorrection(p EquatorialPos, t TD) (Δα, Δδ Angle) {
+	α := p.RA
+	δ := p.Decl
+	ε := TrueObliquity(t)
+	sunLong, _, T := sunGeometric(t)
+	κ := ArcSeconds(20.49552)
+	e := 0.016708634 - T*(0.000042037+T*0.0000001267)
+	π := Degrees(102.93735 + T*(1.71946+T*0.00046)) // longitude of perihelion
+	ek := Angle(e * float64(κ))
+	Δα = (-κ*Angle(cos(α)*cos(sunLong)*cos(ε)+sin(α)*sin(sunLong)) +
+		ek*Angle(cos(α)*cos(π)*cos(ε)+sin(α)*sin(π))) / Angle(cos(δ))
+	q := tan(ε)*cos(δ) - sin(α)*sin(δ)
+	Δδ = -κ*Angle(cos(sunLong)*cos(ε)*q+cos(α)*sin(δ)*sin(sunLong)) +
+		ek*Angle(cos(π)*cos(ε)*q+cos(α)*sin(δ)*sin(π))
+	return
+}
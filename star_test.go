@@ -0,0 +1,60 @@
+package goastro
+
+import (
+	"math"
+	"testing"
+)
+
+// Ch 23 p.152
+var thetaPersei = Star{
+	RA:     Hours(2 + ms(44, 11.986)),
+	Decl:   Degrees(49 + ms(13, 42.48)),
+	PMRA:   Hours(0.03425 / 3600),
+	PMDecl: ArcSeconds(-0.0895),
+}
+
+func TestStarCorrections(t *testing.T) {
+	td := JulianDay(2462088.69).TD()
+	p := EquatorialPos{Degrees(41.547214), Degrees(49.348483)}
+	Δα1, Δδ1 := NutationCorrection(p, td)
+	Δα2, Δδ2 := AberrationCorrection(p, td)
+	cases := []struct {
+		name      string
+		got, want Angle
+	}{
+		{"Δα1", Δα1, ArcSeconds(15.843)},
+		{"Δδ1", Δδ1, ArcSeconds(6.218)},
+		{"Δα2", Δα2, ArcSeconds(30.045)},
+		{"Δδ2", Δδ2, ArcSeconds(6.697)},
+	}
+	for _, c := range cases {
+		if arcSecondDifference(c.got, c.want) > 0.05 {
+			t.Errorf("%s == %.3f\", want %.3f\"", c.name, c.got.ArcSeconds(), c.want.ArcSeconds())
+		}
+	}
+}
+
+func TestStarPosition(t *testing.T) {
+	td := JulianDay(2462088.69).TD()
+	got := thetaPersei.Position(td)
+	want := EquatorialPos{Hours(2 + ms(46, 14.390)), Degrees(49 + ms(21, 7.45))}
+	if arcSecondDifference(got.RA, want.RA) > 0.1 || arcSecondDifference(got.Decl, want.Decl) > 0.1 {
+		t.Errorf("Position(%v) == %v, want %v", td, got, want)
+	}
+}
+
+func TestStarParallax(t *testing.T) {
+	// α Centauri's parallax swings its apparent position by up to ±0.75".
+	star := Star{RA: Degrees(219.9), Decl: Degrees(-60.8), Parallax: ArcSeconds(0.747)}
+	without := star
+	without.Parallax = 0
+	maxShift := 0.0
+	for m := 1; m <= 12; m++ {
+		td := TD{Date{2000, m, 1}, 0}
+		shift := Separation(star.MeanPosition(td).EquatorialPos, without.MeanPosition(td).EquatorialPos).ArcSeconds()
+		maxShift = math.Max(maxShift, shift)
+	}
+	if maxShift < 0.5 || maxShift > 0.75 {
+		t.Errorf("largest parallax shift == %f\", want between 0.5\" and 0.75\"", maxShift)
+	}
+}
@@ -2,7 +2,10 @@ package goastro
 
 import ()
 
-func SunPosition(t TD) EquatorialPos {
+// Ch 25 p.163
+// Returns the Sun's true geometric longitude, its distance in AU and
+// Julian centuries since J2000.
+func sunGeometric(t TD) (Angle, float64, float64) {
 	T := (float64(MakeJulianDay(t)) - 2451545) / 36525
 	//log.Print("T = ", T)
 	L0 := Degrees(280.46646 + T*(36000.76983+T*0.0003032)).Normalize()
@@ -13,17 +16,100 @@ func SunPosition(t TD) EquatorialPos {
 		(0.019993-T*0.000101)*sin(2*M) +
 		0.000289*sin(3*M))
 	//log.Print("C = ", C)
-	long := L0 + C
+	e := 0.016708634 - T*(0.000042037+T*0.0000001267)
+	R := 1.000001018 * (1 - e*e) / (1 + e*cos(M+C))
+	return L0 + C, R, T
+}
+
+// Ch 25 p.164
+// Apparent ecliptic position of the Sun, referred to the true equinox of
+// the date.
+func SunEclipticPosition(t TD) EclipticPos {
+	long, R, T := sunGeometric(t)
 	//log.Print("long = ", long)
 	Ω := Degrees(125.04 - 1934.136*T)
 	//log.Print("Ω = ", Ω)
 	λ := long - Degrees(0.00569+0.00478*sin(Ω))
 	//log.Print("λ = ", λ)
+	return EclipticPos{λ.Normalize(), 0, R}
+}
+
+func SunPosition(t TD) EquatorialPos {
 	ε := TrueObliquity(t)
 	//log.Print("ε = ", ε)
-	α := atan2(cos(ε)*sin(λ), cos(λ))
-	//log.Print("α = ", α)
-	δ := asin(sin(ε) * sin(λ))
-	//log.Print("δ = ", δ)
-	return EquatorialPos{α, δ}
+	return SunEclipticPosition(t).EquatorialPos(ε)
+}
+
+// Ch 25 p.166
+// Apparent ecliptic position of the Sun from the VSOP87 theory of the
+// Earth, good to about 1". Dist is in AU.
+func SunEclipticPositionVSOP87(t TD) EclipticPos {
+	L, B, R := earthVSOP87(t)
+	T := (float64(MakeJulianDay(t)) - 2451545) / 36525
+	Θ := L + 180
+	β := -B
+	// Convert to the FK5 system.
+	λ1 := Θ - Degrees(T*(1.397+T*0.00031))
+	Θ += ArcSeconds(-0.09033)
+	β += ArcSeconds(0.03916 * (cos(λ1) - sin(λ1)))
+	aberration := ArcSeconds(-20.4898 / R)
+	λ := Θ + LongitudeNutation(t) + aberration
+	return EclipticPos{λ.Normalize(), β, R}
+}
+
+// Like SunPosition, but from the VSOP87 theory.
+func SunPositionVSOP87(t TD) EquatorialPos {
+	return SunEclipticPositionVSOP87(t).EquatorialPos(TrueObliquity(t))
+}
+
+// Ch 28 p.183
+// Apparent minus mean solar time, as an angle (E.Hours() gives hours).
+func EquationOfTime(t TD) Angle {
+	τ := (float64(MakeJulianDay(t)) - 2451545) / 365250
+	// Sun's mean longitude
+	L0 := Degrees(poly(τ, 280.4664567, 360007.6982779, 0.03032028, 1/49931., -1/15300., -1/2000000.))
+	α := SunPosition(t).RA
+	Δψ := LongitudeNutation(t)
+	ε := TrueObliquity(t)
+	E := L0 - Degrees(0.0057183) - α + Angle(float64(Δψ)*cos(ε))
+	return E.Normalize180()
+}
+
+// Local mean solar time at ep, and the local date it falls on.
+func LocalMeanTime(t UT, ep EarthPos) (Date, TimeOfDay) {
+	d, h := normalizeHours(t.date, t.hours+ep.Long.Hours())
+	return d, TimeOfDay(h)
+}
+
+// Local apparent (sundial) time at ep, and the local date it falls on.
+func LocalApparentTime(t UT, ep EarthPos) (Date, TimeOfDay) {
+	E := EquationOfTime(t.TD())
+	d, h := normalizeHours(t.date, t.hours+(ep.Long+E).Hours())
+	return d, TimeOfDay(h)
+}
+
+// Returns the UT at which it is local mean time lmt on local date d at ep.
+func LocalMeanTimeUT(d Date, lmt TimeOfDay, ep EarthPos) UT {
+	d, h := normalizeHours(d, float64(lmt)-ep.Long.Hours())
+	return UT{d, h}
+}
+
+// Returns the UT at which it is local apparent time lat on local date d at
+// ep.
+func LocalApparentTimeUT(d Date, lat TimeOfDay, ep EarthPos) UT {
+	t := LocalMeanTimeUT(d, lat, ep)
+	// The first pass applies E at the local mean time, up to 17 minutes
+	// off; E changes by at most 30s a day, so a second pass at the
+	// corrected time is plenty.
+	for i := 0; i < 2; i++ {
+		E := EquationOfTime(t.TD())
+		t = LocalMeanTimeUT(d, lat-TimeOfDay(E.Hours()), ep)
+	}
+	return t
+}
+
+// Returns the UT of local apparent noon, when the Sun transits, on local
+// date d at ep.
+func SolarNoon(d Date, ep EarthPos) UT {
+	return LocalApparentTimeUT(d, 12, ep)
 }
@@ -17,3 +17,87 @@ func TestSunPosition(t *testing.T) {
 		t.Errorf("SunPosition(%v).Decl == %v, want %v", time, got.Decl, wantDecl)
 	}
 }
+
+func TestEquationOfTime(t *testing.T) {
+	// Ch 28 p.184
+	time := TD{Date{1992, 10, 13}, 0}
+	want := Degrees(3.427351)
+	got := EquationOfTime(time)
+	if math.Abs(got.Hours()-want.Hours())*3600 > 1 {
+		t.Errorf("EquationOfTime(%v) == %fm, want %fm", time, got.Hours()*60, want.Hours()*60)
+	}
+}
+
+func TestLocalSolarTime(t *testing.T) {
+	ep := EarthPos{Degrees(42.36462), Degrees(-71.11518)}
+	ut := UT{Date{2012, 12, 4}, 2}
+	d, lmt := LocalMeanTime(ut, ep)
+	if d != (Date{2012, 12, 3}) || math.Abs(float64(lmt)-(26-71.11518/15)) > 1e-9 {
+		t.Errorf("LocalMeanTime(%v) == %v %v", ut, d, lmt)
+	}
+	if back := LocalMeanTimeUT(d, lmt, ep); back.date != ut.date || math.Abs(back.hours-ut.hours) > 1e-9 {
+		t.Errorf("LocalMeanTimeUT(%v, %v) == %v, want %v", d, lmt, back, ut)
+	}
+
+	d, lat := LocalApparentTime(ut, ep)
+	back := LocalApparentTimeUT(d, lat, ep)
+	if back.date != ut.date || math.Abs(back.hours-ut.hours)*3600 > 0.01 {
+		t.Errorf("LocalApparentTimeUT(%v, %v) == %v, want %v", d, lat, back, ut)
+	}
+}
+
+func TestSolarNoon(t *testing.T) {
+	// Same place and date as TestPrayers: dhuhr is at 11:35 EST.
+	ep := EarthPos{Degrees(42.36462), Degrees(-71.11518)}
+	d := Date{2012, 12, 4}
+	got := SolarNoon(d, ep)
+	want := 16 + 35/60.
+	if got.date != d || math.Abs(got.hours-want) > 1/60. {
+		t.Errorf("SolarNoon(%v) == %v, want %v", d, got, TimeOfDay(want))
+	}
+	transit, err := Transit(SunPositioner{}, ep, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(got.hours-transit.hours)*3600 > 2 {
+		t.Errorf("SolarNoon(%v) == %v, but Sun transits at %v", d, got, transit)
+	}
+}
+
+func TestSunEclipticPosition(t *testing.T) {
+	// Ch 25 p.165
+	time := TD{Date{1992, 10, 13}, 0}
+	got := SunEclipticPosition(time)
+	if want := Degrees(199.90895); math.Abs((got.Long-want).Degrees()) > 0.00001 {
+		t.Errorf("SunEclipticPosition(%v).Long == %v, want %v", time, got.Long, want)
+	}
+	if want := 0.99766; math.Abs(got.Dist-want) > 0.00001 {
+		t.Errorf("SunEclipticPosition(%v).Dist == %f, want %f", time, got.Dist, want)
+	}
+}
+
+func TestSunPositionVSOP87(t *testing.T) {
+	// Ch 25 p.169
+	td := TD{Date{1992, 10, 13}, 0}
+	L, B, R := earthVSOP87(td)
+	if math.Abs(L.Degrees()-19.907372) > 0.000001 || math.Abs(B.ArcSeconds()-(-0.644)) > 0.01 || math.Abs(R-0.99760775) > 1e-8 {
+		t.Errorf("earthVSOP87() == %f, %f\", %.8f, want 19.907372, -0.644\", 0.99760775", L.Degrees(), B.ArcSeconds(), R)
+	}
+
+	// Our nutation series is truncated, so λ is good to about 0.2".
+	ec := SunEclipticPositionVSOP87(td)
+	if math.Abs(ec.Long.Degrees()-199.906016) > 0.0001 || math.Abs(ec.Lat.ArcSeconds()-0.62) > 0.01 || ec.Dist != R {
+		t.Errorf("SunEclipticPositionVSOP87() == %f, %f\", %f, want 199.906016, 0.62\", %f", ec.Long.Degrees(), ec.Lat.ArcSeconds(), ec.Dist, R)
+	}
+
+	got := PositionerFunc(SunPositionVSOP87).Position(td)
+	want := EquatorialPos{Degrees(198.378178), -Degrees(7.783871)}
+	if arcSecondDifference(got.RA.Normalize(), want.RA) > 0.2 || arcSecondDifference(got.Decl, want.Decl) > 0.2 {
+		t.Errorf("SunPositionVSOP87() == %v, want %v", got, want)
+	}
+
+	// The low accuracy formula is within 0.01°.
+	if sep := Separation(SunPosition(td), got); sep.Degrees() > 0.01 {
+		t.Errorf("SunPosition() is %v from SunPositionVSOP87()", sep)
+	}
+}
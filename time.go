@@ -35,46 +35,83 @@ func dateHours(t time.Time) float64 {
 	return float64(t.Hour()) + m/60
 }
 
+// Treats t (civil UTC) as UT, which is good to a second, unless Earth
+// orientation data given to SetEOP covers t.
 func MakeUT(t time.Time) UT {
 	t = t.UTC()
-	return UT{MakeDate(t), dateHours(t)}
+	u := UTC{MakeDate(t), dateHours(t)}
+	if dut1, ok := UT1MinusUTC(u); ok {
+		d, h := addSeconds(u.date, u.hours, dut1)
+		return UT{d, h}
+	}
+	return UT{u.date, u.hours}
 }
 
 // Chapter 12 p.87
 func MeanSiderealTime(t UT) Angle {
-	T := (float64(MakeJulianDay(UT{t.date, 0})) - 2451545) / 36525
-	JD := float64(MakeJulianDay(t))
-        return Degrees(280.46061837 + 360.98564736629 * (JD - 2451545) + T*T * (0.000387933 - T / 38710000)).Normalize()
+	T := MakePreciseJulianDay(UT{t.date, 0}).Sub(j2000) / 36525
+	// Whole days contribute whole turns plus 0.98564736629° each, so
+	// only the fraction multiplies the big coefficient.
+	jd := MakePreciseJulianDay(t)
+	days := float64(jd.Day - j2000.Day)
+	θ := 280.46061837 + 360*jd.Frac + 0.98564736629*(days+jd.Frac) + T*T*(0.000387933-T/38710000)
+	return Degrees(θ).Normalize()
 }
 
 // Chapter 12 p.88
 func ApparentSiderealTime(t UT) Angle {
+	return MeanSiderealTime(t) + EquationOfEquinoxes(t)
+}
+
+// Chapter 12 p.88
+// Apparent minus mean sidereal time (nutation in right ascension).
+func EquationOfEquinoxes(t UT) Angle {
 	// XXX This mixes calculations with UT and TD, but that's what the book
 	// does (intentionally), since it doesn't make much of a difference.
 	td := TD{t.date, t.hours}
-	correction := Angle(float64(LongitudeNutation(td)) * cos(TrueObliquity(td)))
-	return MeanSiderealTime(t) + correction
-}
-
-// ΔT = TD - UT (unit = seconds)
-// Chapter 10 p.80
-func DeltaT(d Date) float64 {
-	if d.Year >= 1900 && d.Year <= 1997 {
-		dtime := time.Date(d.Year, time.Month(d.Month), d.Day, 0, 0, 0, 0, time.UTC)
-		epoch := time.Date(1900, time.January, 0, 0, 0, 0, 0, time.UTC)
-		nanoDiff := int64(dtime.Sub(epoch))
-		daysDiff := nanoDiff / 1e9 / 60 / 60 / 24
-		t := float64(daysDiff) / 36525.
-		return ((((((((58353.42*t-232424.66)*t+372919.88)*t-303191.19)*t+124906.15)*t-18756.33)*t-2637.80)*t+815.20)*t+87.24)*t - 2.44
-	} else if d.Year >= 2000 {
-		t := float64(d.Year-2000) / 100
-		ΔT := 102 + 102*t + 25.3*t*t
-		if d.Year < 2100 {
-			ΔT += 0.37 * float64(d.Year-2100)
+	return Angle(float64(LongitudeNutation(td)) * cos(TrueObliquity(td)))
+}
+
+// Apparent sidereal time at ep (longitude positive east).
+func LocalSiderealTime(t UT, ep EarthPos) Angle {
+	return (ApparentSiderealTime(t) + ep.Long).Normalize()
+}
+
+// Degrees of sidereal time per day of UT
+const siderealRate = 360.98564736629
+
+// Returns the instants on d when the apparent local sidereal time at ep is
+// θ. A sidereal day is about 4 minutes shorter than a solar day, so there
+// are two such instants when θ occurs within 4 minutes of 0h UT.
+func LocalSiderealTimeUT(θ Angle, ep EarthPos, d Date) []UT {
+	var uts []UT
+	θ0 := LocalSiderealTime(UT{d, 0}, ep)
+	for m := (θ - θ0).Normalize().Degrees() / siderealRate; m < 1; m += 360 / siderealRate {
+		// Correct for the change in nutation since 0h.
+		for i := 0; i < 2; i++ {
+			Δθ := (θ - LocalSiderealTime(UT{d, 24 * m}, ep)).Normalize180()
+			m += Δθ.Degrees() / siderealRate
+		}
+		if m >= 0 && m < 1 {
+			uts = append(uts, UT{d, 24 * m})
 		}
-		return ΔT
 	}
-	panic("DeltaT: years before 2000 unsupported")
+	return uts
+}
+
+// Carries whole days out of h so that 0 <= h < 24.
+func normalizeHours(d Date, h float64) (Date, float64) {
+	days := math.Floor(h / 24)
+	if days != 0 {
+		d = d.AddDays(int(days))
+		h -= 24 * days
+	}
+	// A tiny negative h rounds to exactly 24 above.
+	if h >= 24 {
+		d = d.AddDays(1)
+		h -= 24
+	}
+	return d, h
 }
 
 func (t TD) Date() Date {
@@ -90,13 +127,8 @@ func (t TD) String() string {
 }
 
 func (t TD) UT() UT {
-	h := t.hours - DeltaT(t.date)/60/60
-	if h < 0 {
-		return UT{t.date.AddDays(-1), h + 24}
-	} else if h >= 24 {
-		return UT{t.date.AddDays(1), h - 24}
-	}
-	return UT{t.date, h}
+	d, h := normalizeHours(t.date, t.hours-DeltaT(t.date)/60/60)
+	return UT{d, h}
 }
 
 func (t UT) Date() Date {
@@ -112,19 +144,125 @@ func (t UT) String() string {
 }
 
 func (t UT) TD() TD {
-	h := t.hours + DeltaT(t.date)/60/60
-	if h >= 24 {
-		return TD{t.date.AddDays(1), h - 24}
-	} else if h < 0 {
-		return TD{t.date.AddDays(-1), h + 24}
-	}
-	return TD{t.date, h}
+	d, h := normalizeHours(t.date, t.hours+DeltaT(t.date)/60/60)
+	return TD{d, h}
 }
 
+// Dates before the Gregorian reform are converted to the proleptic
+// Gregorian calendar that time.Time uses.
 func (t UT) Time() time.Time {
-	h, hf := math.Modf(t.hours)
-	m, mf := math.Modf(hf * 60)
-	s, sf := math.Modf(mf * 60)
-	return time.Date(t.date.Year, time.Month(t.date.Month), t.date.Day,
-		int(h), int(m), int(s), int(sf*1e9), time.UTC)
+	return t.date.Time().Add(time.Duration(math.Round(t.hours * float64(time.Hour))))
+}
+
+// Returns the UT at the given hours on d. Hours outside [0, 24) carry into
+// neighbouring days.
+func (d Date) UT(hours float64) UT {
+	return UT{d, hours}.Normalize()
+}
+
+// Returns the TD at the given hours on d. Hours outside [0, 24) carry into
+// neighbouring days.
+func (d Date) TD(hours float64) TD {
+	return TD{d, hours}.Normalize()
+}
+
+// Days from (d2, h2) to (d1, h1)
+func daysBetween(d1 Date, h1 float64, d2 Date, h2 float64) float64 {
+	return float64(d1.Sub(d2)) + (h1-h2)/24
+}
+
+func durationOfDays(days float64) time.Duration {
+	return time.Duration(math.Round(days * float64(24*time.Hour)))
+}
+
+// Carries hours outside [0, 24) into the date.
+func (t UT) Normalize() UT {
+	d, h := normalizeHours(t.date, t.hours)
+	return UT{d, h}
+}
+
+func (t UT) Add(d time.Duration) UT {
+	return t.AddDays(d.Hours() / 24)
+}
+
+func (t UT) AddDays(n float64) UT {
+	whole, frac := math.Modf(n)
+	d, h := normalizeHours(t.date.AddDays(int(whole)), t.hours+24*frac)
+	return UT{d, h}
+}
+
+// Returns t - u, which must be within ±292 years.
+func (t UT) Sub(u UT) time.Duration {
+	return durationOfDays(daysBetween(t.date, t.hours, u.date, u.hours))
+}
+
+// Returns -1, 0 or +1 as t is before, the same as or after u.
+func (t UT) Compare(u UT) int {
+	switch Δ := daysBetween(t.date, t.hours, u.date, u.hours); {
+	case Δ < 0:
+		return -1
+	case Δ > 0:
+		return 1
+	}
+	return 0
+}
+
+func (t UT) Before(u UT) bool {
+	return t.Compare(u) < 0
+}
+
+func (t UT) After(u UT) bool {
+	return t.Compare(u) > 0
+}
+
+// Reports whether t and u are the same instant, even if one of them is
+// not normalized.
+func (t UT) Equal(u UT) bool {
+	return t.Compare(u) == 0
+}
+
+// Carries hours outside [0, 24) into the date.
+func (t TD) Normalize() TD {
+	d, h := normalizeHours(t.date, t.hours)
+	return TD{d, h}
+}
+
+func (t TD) Add(d time.Duration) TD {
+	return t.AddDays(d.Hours() / 24)
+}
+
+func (t TD) AddDays(n float64) TD {
+	whole, frac := math.Modf(n)
+	d, h := normalizeHours(t.date.AddDays(int(whole)), t.hours+24*frac)
+	return TD{d, h}
+}
+
+// Returns t - u, which must be within ±292 years.
+func (t TD) Sub(u TD) time.Duration {
+	return durationOfDays(daysBetween(t.date, t.hours, u.date, u.hours))
+}
+
+// Returns -1, 0 or +1 as t is before, the same as or after u.
+func (t TD) Compare(u TD) int {
+	switch Δ := daysBetween(t.date, t.hours, u.date, u.hours); {
+	case Δ < 0:
+		return -1
+	case Δ > 0:
+		return 1
+	}
+	return 0
+}
+
+func (t TD) Before(u TD) bool {
+	return t.Compare(u) < 0
+}
+
+func (t TD) After(u TD) bool {
+	return t.Compare(u) > 0
+}
+
+// Reports whether t and u are the same instant, even if one of them is
+// not normalized.
+func (t TD) Equal(u TD) bool {
+	return t.Compare(u) == 0
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"math"
 	"fmt"
+	"time"
 )
 
 func ms(m int, s float64) float64 {
@@ -65,10 +66,11 @@ func TestDeltaT(t *testing.T) {
 		{1980, 50.5},
 		{1990, 56.9},
 		{1996, 61.6},
-		//{2000, 63.8},
-		//{2004, 64.6},
-		//{2008, 65.5},
-		//{2010, 66.1},
+		{1998, 63.0},
+		{2000, 63.8},
+		{2004, 64.6},
+		{2008, 65.5},
+		{2010, 66.1},
 	}
 
 	for _, c := range cases {
@@ -79,3 +81,211 @@ func TestDeltaT(t *testing.T) {
 		}
 	}
 }
+
+func TestDeltaTPolynomials(t *testing.T) {
+	cases := []struct {
+		year int
+		want float64
+	}{
+		{-1000, 25400},
+		{-500, 17190},
+		{0, 10580},
+		{500, 5710},
+		{1000, 1570},
+		{1500, 200},
+		{1600, 120},
+		{2100, 202},
+	}
+
+	for _, c := range cases {
+		d := Date{c.year, 1, 1}
+		got := DeltaT(d)
+		if math.Abs(got-c.want) > c.want/100 {
+			t.Errorf("DeltaT(%v) == %f, want %f", d, got, c.want)
+		}
+	}
+}
+
+func TestDeltaTContinuity(t *testing.T) {
+	// No jumps where the polynomials meet the observed table.
+	for _, d := range []Date{{1620, 1, 1}, {2025, 1, 1}} {
+		before := DeltaT(d.AddDays(-1))
+		after := DeltaT(d)
+		if math.Abs(after-before) > 0.1 {
+			t.Errorf("DeltaT jumps from %f on %v to %f on %v", before, d.AddDays(-1), after, d)
+		}
+	}
+}
+
+func TestLookupDeltaT(t *testing.T) {
+	if _, err := LookupDeltaT(Date{2000, 13, 1}); err == nil {
+		t.Error("LookupDeltaT(invalid date) returned no error")
+	}
+
+	table := DeltaTTable{{2030, 70}, {2040, 72}}
+	if err := SetDeltaTTable(table); err != nil {
+		t.Fatal(err)
+	}
+	defer SetDeltaTTable(nil)
+	d := Date{2035, 1, 1}
+	got, err := LookupDeltaT(d)
+	if err != nil {
+		t.Error(err)
+	}
+	if math.Abs(got-71) > 0.01 {
+		t.Errorf("LookupDeltaT(%v) == %f, want %f", d, got, 71.)
+	}
+
+	if err := SetDeltaTTable(DeltaTTable{{2040, 72}, {2030, 70}}); err == nil {
+		t.Error("SetDeltaTTable(unsorted) returned no error")
+	}
+}
+
+func TestUTToTDAncient(t *testing.T) {
+	// ΔT is several hours here, so the date changes.
+	ut := UT{Date{-1000, 1, 1}, 20}
+	td := ut.TD()
+	if td.date != (Date{-1000, 1, 2}) {
+		t.Errorf("%v.TD() == %v", ut, td)
+	}
+	// ΔT is looked up on the TD date, so allow for its change over a day.
+	back := td.UT()
+	if back.date != ut.date || math.Abs(back.hours-ut.hours)*3600 > 0.1 {
+		t.Errorf("%v.UT() == %v, want %v", td, back, ut)
+	}
+}
+
+func TestEquationOfEquinoxes(t *testing.T) {
+	time := UT{Date{1987, 4, 10}, 0}
+	want := Hours(-0.2317 / 3600)
+	got := EquationOfEquinoxes(time)
+	if timeSecondDifference(want, got) > 0.005 {
+		t.Errorf("EquationOfEquinoxes(%v) == %fs, want %fs", time, got.Hours()*3600, want.Hours()*3600)
+	}
+}
+
+func TestLocalSiderealTime(t *testing.T) {
+	// Ch 13 p.95
+	time := UT{Date{1987, 4, 10}, 19 + 21/60.}
+	ep := EarthPos{Degrees(38 + ms(55, 17)), -Degrees(77 + ms(3, 56))}
+	want := Hours(8+ms(34, 56.853)) - Degrees(77+ms(3, 56))
+	got := LocalSiderealTime(time, ep)
+	if timeSecondDifference(want, got) > 0.005 {
+		t.Errorf("LocalSiderealTime(%v) == %v, want %v", time, HMS(got), HMS(want))
+	}
+}
+
+func TestLocalSiderealTimeUT(t *testing.T) {
+	ep := EarthPos{Degrees(38 + ms(55, 17)), -Degrees(77 + ms(3, 56))}
+	d := Date{1987, 4, 10}
+	cases := []struct {
+		θ Angle
+		n int
+	}{
+		{Hours(8+ms(34, 56.853)) - Degrees(77+ms(3, 56)), 1},
+		{LocalSiderealTime(UT{d, 0}, ep) + Degrees(0.1), 2},
+		{LocalSiderealTime(UT{d, 0}, ep) - Degrees(0.1), 1},
+	}
+	for _, c := range cases {
+		got := LocalSiderealTimeUT(c.θ, ep, d)
+		if len(got) != c.n {
+			t.Errorf("LocalSiderealTimeUT(%v) returned %d instants, want %d", HMS(c.θ), len(got), c.n)
+		}
+		for _, ut := range got {
+			if ut.date != d || timeSecondDifference(LocalSiderealTime(ut, ep), c.θ) > 0.001 {
+				t.Errorf("LocalSiderealTimeUT(%v) returned %v", HMS(c.θ), ut)
+			}
+		}
+	}
+	got := LocalSiderealTimeUT(Hours(8+ms(34, 56.853))-Degrees(77+ms(3, 56)), ep, d)
+	if len(got) == 1 && math.Abs(got[0].hours-(19+21/60.))*3600 > 0.01 {
+		t.Errorf("LocalSiderealTimeUT() == %v, want 19:21 UT", got[0])
+	}
+}
+
+func TestUTArithmetic(t *testing.T) {
+	ut := UT{Date{1999, 12, 31}, 23}
+	later := ut.Add(90 * time.Minute)
+	if later.date != (Date{2000, 1, 1}) || math.Abs(later.hours-0.5) > 1e-9 {
+		t.Errorf("%v.Add(90m) == %v", ut, later)
+	}
+	if got := later.Sub(ut); got != 90*time.Minute {
+		t.Errorf("%v.Sub(%v) == %v, want 90m", later, ut, got)
+	}
+	if !ut.Before(later) || !later.After(ut) || ut.After(later) {
+		t.Errorf("%v and %v are misordered", ut, later)
+	}
+	if earlier := ut.AddDays(-365.25); earlier.date != (Date{1998, 12, 31}) || math.Abs(earlier.hours-17) > 1e-9 {
+		t.Errorf("%v.AddDays(-365.25) == %v", ut, earlier)
+	}
+
+	odd := UT{Date{2000, 1, 1}, -1}
+	if !odd.Equal(ut) {
+		t.Errorf("%v.Equal(%v) == false", odd, ut)
+	}
+	if got := odd.Normalize(); got != ut {
+		t.Errorf("%v.Normalize() == %v, want %v", odd, got, ut)
+	}
+	if got := (Date{2000, 1, 1}).UT(49.5); got != (UT{Date{2000, 1, 3}, 1.5}) {
+		t.Errorf("Date.UT(49.5) == %v", got)
+	}
+}
+
+func TestTDArithmetic(t *testing.T) {
+	td := (Date{2024, 2, 28}).TD(12)
+	later := td.AddDays(1.5)
+	if later != (TD{Date{2024, 3, 1}, 0}) {
+		t.Errorf("%v.AddDays(1.5) == %v", td, later)
+	}
+	if got := later.Sub(td); got != 36*time.Hour {
+		t.Errorf("%v.Sub(%v) == %v, want 36h", later, td, got)
+	}
+	if got := td.Add(-12 * time.Hour); got != (TD{Date{2024, 2, 28}, 0}) {
+		t.Errorf("%v.Add(-12h) == %v", td, got)
+	}
+	if td.Compare(later) != -1 || later.Compare(td) != 1 || td.Compare(td) != 0 {
+		t.Errorf("%v and %v are misordered", td, later)
+	}
+}
+
+func TestUTTimeRoundTrip(t *testing.T) {
+	for _, want := range []time.Time{
+		time.Date(1500, 3, 1, 6, 0, 0, 0, time.UTC), // Julian 1500-02-20
+		time.Date(1582, 10, 14, 23, 30, 15, 500000000, time.UTC),
+		time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC),
+	} {
+		u := UT{MakeDate(want), dateHours(want)}
+		if got := u.Time(); !got.Equal(want) {
+			t.Errorf("%v.Time() == %v, want %v", u, got, want)
+		}
+		utc := MakeUTC(want)
+		if got := utc.Time(); !got.Equal(want) {
+			t.Errorf("%v.Time() == %v, want %v", utc, got, want)
+		}
+	}
+}
+
+func TestNormalizeHoursEdges(t *testing.T) {
+	cases := []struct {
+		d     Date
+		h     float64
+		wantD Date
+	}{
+		// Rounds to midnight at the start of the day rather than 24h on
+		// the previous one.
+		{Date{2000, 1, 2}, -1e-15, Date{2000, 1, 2}},
+		{Date{2000, 1, 2}, 24 - 1e-14, Date{2000, 1, 2}},
+		{Date{2000, 1, 2}, 24, Date{2000, 1, 3}},
+		{Date{2000, 1, 2}, -24, Date{2000, 1, 1}},
+	}
+	for _, c := range cases {
+		u := UT{c.d, c.h}.Normalize()
+		if u.date != c.wantD || u.hours < 0 || u.hours >= 24 {
+			t.Errorf("UT{%v, %g}.Normalize() == %v (%g h), want date %v and 0 <= h < 24", c.d, c.h, u, u.hours, c.wantD)
+		}
+		td := TD{c.d, c.h}.Normalize()
+		if td.date != c.wantD || td.hours < 0 || td.hours >= 24 {
+			t.Errorf("TD{%v, %g}.Normalize() == %v (%g h), want date %v and 0 <= h < 24", c.d, c.h, td, td.hours, c.wantD)
+		}
+	}
+}
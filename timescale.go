@@ -0,0 +1,194 @@
+package goastro
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Terrestrial Time, which is what the algorithms in this package call
+// Dynamical Time.
+type TT = TD
+
+// The Universal Time used throughout this package is UT1.
+type UT1 = UT
+
+// Coordinated Universal Time. On a day ending in a leap second, hours run
+// up to 24 + 1/3600.
+type UTC struct {
+	date  Date
+	hours float64
+}
+
+// International Atomic Time
+type TAI struct {
+	date  Date
+	hours float64
+}
+
+// Barycentric Dynamical Time
+type TDB struct {
+	date  Date
+	hours float64
+}
+
+// TT - TAI (unit = seconds)
+const ttMinusTAI = 32.184
+
+func addSeconds(d Date, h, s float64) (Date, float64) {
+	return normalizeHours(d, h+s/3600)
+}
+
+// Compares the instants (d1, h1) and (d2, h2) in the same time scale,
+// treating times within a microsecond as equal.
+func instantBefore(d1 Date, h1 float64, d2 Date, h2 float64) bool {
+	if d1 != d2 {
+		return d1.Before(d2)
+	}
+	return h1 < h2-1e-6/3600
+}
+
+// Go's time.Time has no leap seconds, so t is never in one.
+func MakeUTC(t time.Time) UTC {
+	t = t.UTC()
+	return UTC{MakeDate(t), dateHours(t)}
+}
+
+func (t UTC) Date() Date {
+	return t.date
+}
+
+func (t UTC) Hours() float64 {
+	return t.hours
+}
+
+func (t UTC) String() string {
+	if t.hours >= 24 {
+		tenths := int(math.Round((t.hours - 24) * 36000))
+		return fmt.Sprintf("UTC %v 23:59:%02d.%01d", t.date, 60+tenths/10, tenths%10)
+	}
+	return fmt.Sprintf("UTC %v %v", t.date, TimeOfDay(t.hours))
+}
+
+// A leap second becomes the first second of the next day.
+func (t UTC) Time() time.Time {
+	return UT{t.date, t.hours}.Time()
+}
+
+// Before the leap second table starts, UTC is taken to be UT.
+func (t UTC) TAI() TAI {
+	offset, ok := TAIMinusUTC(t.date)
+	if !ok {
+		return UT{t.date, t.hours}.TD().TAI()
+	}
+	d, h := addSeconds(t.date, t.hours, float64(offset))
+	return TAI{d, h}
+}
+
+func (t UTC) TD() TD {
+	return t.TAI().TD()
+}
+
+// Uses UT1-UTC from the table given to SetEOP; without it, UT is derived
+// from ΔT.
+func (t UTC) UT() UT {
+	if dut1, ok := UT1MinusUTC(t); ok {
+		d, h := addSeconds(t.date, t.hours, dut1)
+		return UT{d, h}
+	}
+	return t.TD().UT()
+}
+
+func (t TAI) Date() Date {
+	return t.date
+}
+
+func (t TAI) Hours() float64 {
+	return t.hours
+}
+
+func (t TAI) String() string {
+	return fmt.Sprintf("TAI %v %v", t.date, TimeOfDay(t.hours))
+}
+
+func (t TAI) TD() TD {
+	d, h := addSeconds(t.date, t.hours, ttMinusTAI)
+	return TD{d, h}
+}
+
+func (t TAI) UTC() UTC {
+	// Find the last leap second in effect, comparing in TAI.
+	table := currentLeapSeconds()
+	i := len(table) - 1
+	for ; i >= 0; i-- {
+		ls := table[i]
+		if !instantBefore(t.date, t.hours, ls.Date, float64(ls.Offset)/3600) {
+			break
+		}
+	}
+	if i < 0 {
+		u := t.TD().UT()
+		return UTC{u.date, u.hours}
+	}
+	d, h := addSeconds(t.date, t.hours, -float64(table[i].Offset))
+	if i+1 < len(table) && !d.Before(table[i+1].Date) {
+		// t is in the leap second at the end of the previous day.
+		return UTC{d.AddDays(-1), h + 24}
+	}
+	return UTC{d, h}
+}
+
+func (t TD) TAI() TAI {
+	d, h := addSeconds(t.date, t.hours, -ttMinusTAI)
+	return TAI{d, h}
+}
+
+func (t TD) UTC() UTC {
+	return t.TAI().UTC()
+}
+
+// Inverts UTC.UT: uses UT1-UTC from the table given to SetEOP if it
+// covers t; without it, UTC is derived from ΔT and the leap seconds.
+func (t UT) UTC() UTC {
+	u := UTC{t.date, t.hours}
+	// UT1-UTC changes by a few milliseconds a day, so the UTC it is
+	// looked up at barely matters; two passes settle it.
+	for i := 0; i < 2; i++ {
+		dut1, ok := UT1MinusUTC(u)
+		if !ok {
+			return t.TD().UTC()
+		}
+		d, h := addSeconds(t.date, t.hours, -dut1)
+		u = UTC{d, h}
+	}
+	return u
+}
+
+// TDB - TT (unit = seconds)
+// Explanatory Supplement to the Astronomical Almanac (1992) eq. 2.222-1
+func tdbMinusTT(t Time) float64 {
+	g := Degrees(357.53 + 0.98560028*(float64(MakeJulianDay(t))-2451545))
+	return 0.001657*sin(g) + 0.000014*sin(2*g)
+}
+
+func (t TD) TDB() TDB {
+	d, h := addSeconds(t.date, t.hours, tdbMinusTT(t))
+	return TDB{d, h}
+}
+
+func (t TDB) Date() Date {
+	return t.date
+}
+
+func (t TDB) Hours() float64 {
+	return t.hours
+}
+
+func (t TDB) String() string {
+	return fmt.Sprintf("TDB %v %v", t.date, TimeOfDay(t.hours))
+}
+
+func (t TDB) TD() TD {
+	d, h := addSeconds(t.date, t.hours, -tdbMinusTT(t))
+	return TD{d, h}
+}
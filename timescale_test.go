@@ -0,0 +1,77 @@
+package goastro
+
+import (
+	"math"
+	"testing"
+)
+
+func hms(h, m int, s float64) float64 {
+	return float64(h) + ms(m, s)
+}
+
+func sameInstant(d1 Date, h1 float64, d2 Date, h2 float64) bool {
+	Δh := float64(d1.Sub(d2))*24 + h1 - h2
+	return math.Abs(Δh)*3600 < 1e-6
+}
+
+func TestUTCToTAI(t *testing.T) {
+	cases := []struct {
+		utc UTC
+		tai TAI
+	}{
+		{UTC{Date{2017, 1, 1}, 0}, TAI{Date{2017, 1, 1}, hms(0, 0, 37)}},
+		{UTC{Date{2016, 12, 31}, hms(23, 59, 59.5)}, TAI{Date{2017, 1, 1}, hms(0, 0, 35.5)}},
+		{UTC{Date{2016, 12, 31}, hms(24, 0, 0.5)}, TAI{Date{2017, 1, 1}, hms(0, 0, 36.5)}},
+		{UTC{Date{1999, 6, 1}, 12}, TAI{Date{1999, 6, 1}, hms(12, 0, 32)}},
+		{UTC{Date{1972, 1, 1}, 0}, TAI{Date{1972, 1, 1}, hms(0, 0, 10)}},
+	}
+
+	for _, c := range cases {
+		got := c.utc.TAI()
+		if !sameInstant(got.date, got.hours, c.tai.date, c.tai.hours) {
+			t.Errorf("%v.TAI() == %v, want %v", c.utc, got, c.tai)
+		}
+		back := c.tai.UTC()
+		if !sameInstant(back.date, back.hours, c.utc.date, c.utc.hours) {
+			t.Errorf("%v.UTC() == %v, want %v", c.tai, back, c.utc)
+		}
+	}
+}
+
+func TestUTCString(t *testing.T) {
+	u := UTC{Date{2016, 12, 31}, hms(24, 0, 0.5)}
+	if got, want := u.String(), "UTC 2016-12-31 23:59:60.5"; got != want {
+		t.Errorf("String() == %q, want %q", got, want)
+	}
+}
+
+func TestUTCToTD(t *testing.T) {
+	u := UTC{Date{2020, 3, 1}, 12}
+	got := u.TD()
+	want := TD{Date{2020, 3, 1}, hms(12, 1, 9.184)}
+	if !sameInstant(got.date, got.hours, want.date, want.hours) {
+		t.Errorf("%v.TD() == %v, want %v", u, got, want)
+	}
+	back := got.UTC()
+	if !sameInstant(back.date, back.hours, u.date, u.hours) {
+		t.Errorf("%v.UTC() == %v, want %v", got, back, u)
+	}
+	// UT1 is within a second of UTC.
+	if ut := u.UT(); ut.date != u.date || math.Abs(ut.hours-u.hours)*3600 > 1 {
+		t.Errorf("%v.UT() == %v", u, ut)
+	}
+}
+
+func TestTDB(t *testing.T) {
+	for m := 1; m <= 12; m++ {
+		td := TD{Date{2000, m, 1}, 12}
+		tdb := td.TDB()
+		if d := math.Abs(tdb.hours-td.hours) * 3600; d > 0.0017 || tdb.date != td.date {
+			t.Errorf("%v.TDB() == %v, differs by %fs", td, tdb, d)
+		}
+		back := tdb.TD()
+		if !sameInstant(back.date, back.hours, td.date, td.hours) {
+			t.Errorf("%v.TD() == %v, want %v", tdb, back, td)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package goastro
+
+// An observer on the Earth's surface.
+type Observer struct {
+	EarthPos
+	Height float64 // metres above sea level
+}
+
+// Ch 11 p.82
+const (
+	earthEquatorialRadius = 6378140 // metres
+	earthPolarRatio       = 0.99664719
+)
+
+// Ch 11 p.82
+// Returns ρ sin φ' and ρ cos φ', the observer's distance from the Earth's
+// axis and from the equatorial plane, in equatorial radii.
+func (o Observer) ParallaxFactors() (ρsinφ, ρcosφ float64) {
+	φ := o.Lat
+	u := atan(earthPolarRatio * tan(φ))
+	h := o.Height / earthEquatorialRadius
+	ρsinφ = earthPolarRatio*sin(u) + h*sin(φ)
+	ρcosφ = cos(u) + h*cos(φ)
+	return
+}
+
+// Ch 40 p.279
+// Returns the equatorial horizontal parallax of a body at distance Δ (AU).
+func HorizontalParallax(Δ float64) Angle {
+	return asin(sin(ArcSeconds(8.794)) / Δ)
+}
+
+// Ch 40 p.279
+// Converts the geocentric position p of a body at distance Δ (AU) to the
+// position seen by o. θ0 is the apparent sidereal time at Greenwich.
+func (p EquatorialPos) Topocentric(Δ float64, θ0 Angle, o Observer) EquatorialPos {
+	ρsinφ, ρcosφ := o.ParallaxFactors()
+	sinπ := sin(HorizontalParallax(Δ))
+	α := p.RA
+	δ := p.Decl
+	H := θ0 + o.Long - α
+	den := cos(δ) - ρcosφ*sinπ*cos(H)
+	Δα := atan2(-ρcosφ*sinπ*sin(H), den)
+	δ1 := atan2((sin(δ)-ρsinφ*sinπ)*cos(Δα), den)
+	return EquatorialPos{(α + Δα).Normalize(), δ1}
+}
+
+// Like HorizontalPos, but as seen by o rather than from the Earth's centre.
+func (p EquatorialPos) TopocentricHorizontalPos(Δ float64, θ0 Angle, o Observer, opts ...HorizontalOption) HorizontalPos {
+	return p.Topocentric(Δ, θ0, o).HorizontalPos(θ0, o.EarthPos, opts...)
+}
@@ -0,0 +1,42 @@
+package goastro
+
+import (
+	"math"
+	"testing"
+)
+
+// Palomar Observatory, Ch 11 p.83
+var palomar = Observer{EarthPos{Degrees(33 + ms(21, 22)), -Hours(7 + ms(47, 27))}, 1706}
+
+func TestParallaxFactors(t *testing.T) {
+	ρsinφ, ρcosφ := palomar.ParallaxFactors()
+	if math.Abs(ρsinφ-0.546861) > 1e-6 || math.Abs(ρcosφ-0.836339) > 1e-6 {
+		t.Errorf("ParallaxFactors() == %f, %f, want 0.546861, 0.836339", ρsinφ, ρcosφ)
+	}
+}
+
+// Ch 40 p.280
+func TestTopocentric(t *testing.T) {
+	mars := EquatorialPos{Degrees(339.530208), -Degrees(15.771083)}
+	θ0 := Hours(1 + ms(40, 45))
+	got := mars.Topocentric(0.37276, θ0, palomar)
+	want := EquatorialPos{Hours(22 + ms(38, 8.54)), -Degrees(15 + ms(46, 30.0))}
+	if timeSecondDifference(got.RA, want.RA) > 0.01 || arcSecondDifference(got.Decl, want.Decl) > 0.1 {
+		t.Errorf("Topocentric() == %v, want %v", got, want)
+	}
+}
+
+func TestTopocentricHorizontalPos(t *testing.T) {
+	// The Moon near the horizon is lowered by about its horizontal parallax.
+	Δ := 0.00257
+	θ0 := Degrees(100)
+	ep := EarthPos{0, 0}
+	moon := EquatorialPos{Degrees(11), 0}
+	geo := moon.HorizontalPos(θ0, ep)
+	topo := moon.TopocentricHorizontalPos(Δ, θ0, Observer{ep, 0})
+	π := HorizontalParallax(Δ)
+	want := π.Degrees() * cos(geo.Alt)
+	if got := (geo.Alt - topo.Alt).Degrees(); math.Abs(got-want) > 0.01 {
+		t.Errorf("parallax in altitude == %f°, want %f°", got, want)
+	}
+}
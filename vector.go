@@ -0,0 +1,200 @@
+package goastro
+
+import (
+	"math"
+)
+
+// A rectangular vector. For directions on the sky it is usually a unit
+// vector.
+type Vec3 [3]float64
+
+// A 3x3 matrix, usually a rotation from one frame to another.
+type Mat3 [3][3]float64
+
+func IdentityMat3() Mat3 {
+	return Mat3{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+}
+
+func (v Vec3) Add(w Vec3) Vec3 {
+	return Vec3{v[0] + w[0], v[1] + w[1], v[2] + w[2]}
+}
+
+func (v Vec3) Sub(w Vec3) Vec3 {
+	return Vec3{v[0] - w[0], v[1] - w[1], v[2] - w[2]}
+}
+
+func (v Vec3) Scale(k float64) Vec3 {
+	return Vec3{k * v[0], k * v[1], k * v[2]}
+}
+
+func (v Vec3) Dot(w Vec3) float64 {
+	return v[0]*w[0] + v[1]*w[1] + v[2]*w[2]
+}
+
+func (v Vec3) Cross(w Vec3) Vec3 {
+	return Vec3{
+		v[1]*w[2] - v[2]*w[1],
+		v[2]*w[0] - v[0]*w[2],
+		v[0]*w[1] - v[1]*w[0],
+	}
+}
+
+func (v Vec3) Len() float64 {
+	return math.Sqrt(v.Dot(v))
+}
+
+func (v Vec3) Unit() Vec3 {
+	return v.Scale(1 / v.Len())
+}
+
+// Returns the product mn.
+func (m Mat3) Mul(n Mat3) Mat3 {
+	var r Mat3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				r[i][j] += m[i][k] * n[k][j]
+			}
+		}
+	}
+	return r
+}
+
+// Returns the transform that applies m and then n, i.e. nm, so that
+// reductions read in the order they're done.
+func (m Mat3) Then(n Mat3) Mat3 {
+	return n.Mul(m)
+}
+
+func (m Mat3) Apply(v Vec3) Vec3 {
+	var r Vec3
+	for i := 0; i < 3; i++ {
+		r[i] = m[i][0]*v[0] + m[i][1]*v[1] + m[i][2]*v[2]
+	}
+	return r
+}
+
+// The inverse of a rotation.
+func (m Mat3) Transpose() Mat3 {
+	var r Mat3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[i][j] = m[j][i]
+		}
+	}
+	return r
+}
+
+// Rotations of the frame (not the vector) by a about the x, y and z axes,
+// anticlockwise seen from the positive end of the axis.
+func RotX(a Angle) Mat3 {
+	s, c := sin(a), cos(a)
+	return Mat3{{1, 0, 0}, {0, c, s}, {0, -s, c}}
+}
+
+func RotY(a Angle) Mat3 {
+	s, c := sin(a), cos(a)
+	return Mat3{{c, 0, -s}, {0, 1, 0}, {s, 0, c}}
+}
+
+func RotZ(a Angle) Mat3 {
+	s, c := sin(a), cos(a)
+	return Mat3{{c, s, 0}, {-s, c, 0}, {0, 0, 1}}
+}
+
+// Unit vector: x towards the equinox, z towards the north pole.
+func (p EquatorialPos) Vec3() Vec3 {
+	return Vec3{cos(p.Decl) * cos(p.RA), cos(p.Decl) * sin(p.RA), sin(p.Decl)}
+}
+
+// The length of v is ignored.
+func (v Vec3) EquatorialPos() EquatorialPos {
+	α, δ := v.spherical()
+	return EquatorialPos{α, δ}
+}
+
+func (v Vec3) spherical() (long, lat Angle) {
+	return atan2(v[1], v[0]).Normalize(), atan2(v[2], math.Hypot(v[0], v[1]))
+}
+
+// x towards the equinox, z towards the north ecliptic pole. The length is
+// p.Dist, or 1 if the distance is unknown; use Vec3.EclipticPos to get
+// back an unknown distance and Vec3.EclipticPosWithDist for a known one.
+func (p EclipticPos) Vec3() Vec3 {
+	r := p.Dist
+	if r == 0 {
+		r = 1
+	}
+	return Vec3{r * cos(p.Lat) * cos(p.Long), r * cos(p.Lat) * sin(p.Long), r * sin(p.Lat)}
+}
+
+// The length of v is ignored, and the distance is unknown (0).
+func (v Vec3) EclipticPos() EclipticPos {
+	λ, β := v.spherical()
+	return EclipticPos{λ, β, 0}
+}
+
+// The distance is the length of v.
+func (v Vec3) EclipticPosWithDist() EclipticPos {
+	λ, β := v.spherical()
+	return EclipticPos{λ, β, v.Len()}
+}
+
+// x towards the south, y towards the west, z towards the zenith, matching
+// the book's azimuth measured westwards from the south.
+func (p HorizontalPos) Vec3() Vec3 {
+	return Vec3{cos(p.Alt) * cos(p.Azi), cos(p.Alt) * sin(p.Azi), sin(p.Alt)}
+}
+
+func (v Vec3) HorizontalPos() HorizontalPos {
+	A, h := v.spherical()
+	return HorizontalPos{A, h}
+}
+
+// Ch 13 p.93
+func EclipticToEquatorial(ε Angle) Mat3 {
+	return RotX(-ε)
+}
+
+func EquatorialToEcliptic(ε Angle) Mat3 {
+	return RotX(ε)
+}
+
+// θ is the local sidereal time. Hour angles are measured westwards, so
+// this flips the handedness of the frame.
+func EquatorialToHorizontal(θ Angle, lat Angle) Mat3 {
+	flip := Mat3{{1, 0, 0}, {0, -1, 0}, {0, 0, 1}}
+	return RotZ(θ).Then(flip).Then(RotY(90 - lat))
+}
+
+func HorizontalToEquatorial(θ Angle, lat Angle) Mat3 {
+	return EquatorialToHorizontal(θ, lat).Transpose()
+}
+
+// Ch 21 p.134
+// From the mean equator and equinox of from to that of to.
+func PrecessionMatrix(from, to JulianDay, model PrecessionModel) Mat3 {
+	rotation := func(ζ, z, θ Angle) Mat3 {
+		return RotZ(-ζ).Then(RotY(θ)).Then(RotZ(-z))
+	}
+	if model == IAU1976 {
+		return rotation(precessionAngles1976(from, to))
+	}
+	return rotation(precessionAngles2006(from)).Transpose().Then(rotation(precessionAngles2006(to)))
+}
+
+// From the mean equator and equinox of t to the true ones.
+func NutationMatrix(t TD) Mat3 {
+	ε0 := MeanObliquity(t)
+	ε := TrueObliquity(t)
+	return RotX(ε0).Then(RotZ(-LongitudeNutation(t))).Then(RotX(-ε))
+}
+
+// IERS Conventions (2003) ch. 5
+// From the ICRS to the mean equator and equinox of J2000.
+func FrameBias() Mat3 {
+	ξ0 := ArcSeconds(-0.0166170)
+	η0 := ArcSeconds(-0.0068192)
+	dα0 := ArcSeconds(-0.01460)
+	return RotZ(dα0).Then(RotY(ξ0)).Then(RotX(-η0))
+}
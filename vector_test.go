@@ -0,0 +1,99 @@
+package goastro
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVec3RoundTrip(t *testing.T) {
+	eq := EquatorialPos{Degrees(116.328942), Degrees(28.026183)}
+	if back := eq.Vec3().EquatorialPos(); arcSecondDifference(back.RA, eq.RA) > 1e-6 || arcSecondDifference(back.Decl, eq.Decl) > 1e-6 {
+		t.Errorf("EquatorialPos round trip == %v, want %v", back, eq)
+	}
+	ec := EclipticPos{Degrees(200), -Degrees(5), 1.5}
+	if back := ec.Vec3().EclipticPosWithDist(); arcSecondDifference(back.Long, ec.Long) > 1e-6 || arcSecondDifference(back.Lat, ec.Lat) > 1e-6 || math.Abs(back.Dist-ec.Dist) > 1e-12 {
+		t.Errorf("EclipticPos round trip == %v, want %v", back, ec)
+	}
+	unknown := EclipticPos{Degrees(200), -Degrees(5), 0}
+	if back := unknown.Vec3().EclipticPos(); back.Dist != 0 || arcSecondDifference(back.Long, unknown.Long) > 1e-6 {
+		t.Errorf("EclipticPos round trip with unknown distance == %v, want %v", back, unknown)
+	}
+	// Straight up: the longitude is arbitrary but the result is finite.
+	if p := (Vec3{0, 0, 2}).EquatorialPos(); p.Decl != 90 || p.RA.IsNaN() {
+		t.Errorf("pole == %v", p)
+	}
+}
+
+func TestEclipticMatrix(t *testing.T) {
+	// Ch 13 p.95: Pollux
+	eq := EquatorialPos{Degrees(116.328942), Degrees(28.026183)}
+	ε := Degrees(23.4392911)
+	got := EquatorialToEcliptic(ε).Apply(eq.Vec3()).EclipticPos()
+	want := eq.EclipticPos(ε)
+	if arcSecondDifference(got.Long, want.Long) > 1e-6 || arcSecondDifference(got.Lat, want.Lat) > 1e-6 {
+		t.Errorf("ecliptic == %v, want %v", got, want)
+	}
+	back := EclipticToEquatorial(ε).Apply(got.Vec3()).EquatorialPos()
+	if arcSecondDifference(back.RA, eq.RA) > 1e-6 || arcSecondDifference(back.Decl, eq.Decl) > 1e-6 {
+		t.Errorf("equatorial == %v, want %v", back, eq)
+	}
+}
+
+func TestHorizontalMatrix(t *testing.T) {
+	// Ch 13 p.95
+	ut := UT{Date{1987, 4, 10}, 19 + 21/60.}
+	eq := EquatorialPos{Hours(23 + ms(9, 16.641)), -Degrees(6 + ms(43, 11.61))}
+	ep := EarthPos{Degrees(38 + ms(55, 17)), -Degrees(77 + ms(3, 56))}
+	θ := LocalSiderealTime(ut, ep)
+	m := EquatorialToHorizontal(θ, ep.Lat)
+	got := m.Apply(eq.Vec3()).HorizontalPos()
+	want := eq.HorizontalPos(ApparentSiderealTime(ut), ep)
+	if arcSecondDifference(got.Azi, want.Azi.Normalize()) > 1e-6 || arcSecondDifference(got.Alt, want.Alt) > 1e-6 {
+		t.Errorf("horizontal == %v, want %v", got, want)
+	}
+	back := HorizontalToEquatorial(θ, ep.Lat).Apply(got.Vec3()).EquatorialPos()
+	if arcSecondDifference(back.RA, eq.RA) > 1e-6 || arcSecondDifference(back.Decl, eq.Decl) > 1e-6 {
+		t.Errorf("equatorial == %v, want %v", back, eq)
+	}
+}
+
+func TestPrecessionMatrix(t *testing.T) {
+	p := EpochPos{EquatorialPos{Degrees(41.054063), Degrees(49.227750)}, J2000}
+	to := JulianDay(2462088.69)
+	for _, model := range []PrecessionModel{IAU1976, IAU2006} {
+		got := PrecessionMatrix(p.Epoch, to, model).Apply(p.Vec3()).EquatorialPos()
+		want := p.Precess(to, model)
+		if arcSecondDifference(got.RA, want.RA) > 1e-6 || arcSecondDifference(got.Decl, want.Decl) > 1e-6 {
+			t.Errorf("PrecessionMatrix(%v) gives %v, want %v", model, got, want.EquatorialPos)
+		}
+	}
+}
+
+func TestNutationMatrix(t *testing.T) {
+	// Ch 23 p.152
+	td := JulianDay(2462088.69).TD()
+	p := EquatorialPos{Degrees(41.547214), Degrees(49.348483)}
+	got := NutationMatrix(td).Apply(p.Vec3()).EquatorialPos()
+	Δα, Δδ := NutationCorrection(p, td)
+	if arcSecondDifference(got.RA, p.RA+Δα) > 0.001 || arcSecondDifference(got.Decl, p.Decl+Δδ) > 0.001 {
+		t.Errorf("nutation == %v, want %v", got, EquatorialPos{p.RA + Δα, p.Decl + Δδ})
+	}
+}
+
+func TestFrameBias(t *testing.T) {
+	// The bias is about 23 milliarcseconds.
+	p := EquatorialPos{0, 0}
+	got := Separation(FrameBias().Apply(p.Vec3()).EquatorialPos(), p).ArcSeconds()
+	if got < 0.01 || got > 0.03 {
+		t.Errorf("frame bias moves the equinox by %f\"", got)
+	}
+	m := FrameBias().Then(FrameBias().Transpose())
+	identity := IdentityMat3()
+	for i := range m {
+		for j := range m[i] {
+			if math.Abs(m[i][j]-identity[i][j]) > 1e-15 {
+				t.Fatalf("bias then inverse == %v", m)
+			}
+		}
+	}
+}